@@ -24,19 +24,46 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
-	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	ErrorReason_GREETER_UNSPECIFIED    ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND         ErrorReason = 1
+	ErrorReason_ARTICLE_NOT_FOUND      ErrorReason = 2
+	ErrorReason_REVISION_NOT_FOUND     ErrorReason = 3
+	ErrorReason_VERSION_CONFLICT       ErrorReason = 4
+	ErrorReason_VERSION_REQUIRED       ErrorReason = 5
+	ErrorReason_SLUG_CONFLICT          ErrorReason = 6
+	ErrorReason_ARTICLE_MOVED          ErrorReason = 7
+	ErrorReason_COMMENT_NOT_FOUND      ErrorReason = 8
+	ErrorReason_NOTIFICATION_NOT_FOUND ErrorReason = 9
+	ErrorReason_WEBHOOK_NOT_FOUND      ErrorReason = 10
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "GREETER_UNSPECIFIED",
-		1: "USER_NOT_FOUND",
+		0:  "GREETER_UNSPECIFIED",
+		1:  "USER_NOT_FOUND",
+		2:  "ARTICLE_NOT_FOUND",
+		3:  "REVISION_NOT_FOUND",
+		4:  "VERSION_CONFLICT",
+		5:  "VERSION_REQUIRED",
+		6:  "SLUG_CONFLICT",
+		7:  "ARTICLE_MOVED",
+		8:  "COMMENT_NOT_FOUND",
+		9:  "NOTIFICATION_NOT_FOUND",
+		10: "WEBHOOK_NOT_FOUND",
 	}
 	ErrorReason_value = map[string]int32{
-		"GREETER_UNSPECIFIED": 0,
-		"USER_NOT_FOUND":      1,
+		"GREETER_UNSPECIFIED":    0,
+		"USER_NOT_FOUND":         1,
+		"ARTICLE_NOT_FOUND":      2,
+		"REVISION_NOT_FOUND":     3,
+		"VERSION_CONFLICT":       4,
+		"VERSION_REQUIRED":       5,
+		"SLUG_CONFLICT":          6,
+		"ARTICLE_MOVED":          7,
+		"COMMENT_NOT_FOUND":      8,
+		"NOTIFICATION_NOT_FOUND": 9,
+		"WEBHOOK_NOT_FOUND":      10,
 	}
 )
 
@@ -71,10 +98,20 @@ var File_realworld_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_realworld_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1frealworld/v1/error_reason.proto\x12\frealworld.v1*:\n" +
+	"\x1frealworld/v1/error_reason.proto\x12\frealworld.v1*\x85\x02\n" +
 	"\vErrorReason\x12\x17\n" +
 	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_NOT_FOUND\x10\x01B&Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
+	"\x0eUSER_NOT_FOUND\x10\x01\x12\x15\n" +
+	"\x11ARTICLE_NOT_FOUND\x10\x02\x12\x16\n" +
+	"\x12REVISION_NOT_FOUND\x10\x03\x12\x14\n" +
+	"\x10VERSION_CONFLICT\x10\x04\x12\x14\n" +
+	"\x10VERSION_REQUIRED\x10\x05\x12\x11\n" +
+	"\rSLUG_CONFLICT\x10\x06\x12\x11\n" +
+	"\rARTICLE_MOVED\x10\a\x12\x15\n" +
+	"\x11COMMENT_NOT_FOUND\x10\b\x12\x1a\n" +
+	"\x16NOTIFICATION_NOT_FOUND\x10\t\x12\x15\n" +
+	"\x11WEBHOOK_NOT_FOUND\x10\n" +
+	"B&Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
 
 var (
 	file_realworld_v1_error_reason_proto_rawDescOnce sync.Once
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -112,8 +113,11 @@ func (x *RegisterRequest) GetUser() *RegisterRequest_User {
 }
 
 type UpdateUserRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	User          *UpdateUserRequest_User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	User  *UpdateUserRequest_User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// 要更新的 user 字段，如 "bio,image"；为空时 HTTP 请求按 JSON 中的显式 null 和非空值推断（空串视为没传），
+	// gRPC 请求只更新非空字段
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -155,6 +159,13 @@ func (x *UpdateUserRequest) GetUser() *UpdateUserRequest_User {
 	return nil
 }
 
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -243,31 +254,30 @@ func (x *FollowUserRequest) GetUsername() string {
 	return ""
 }
 
-type ListArticlesRequest struct {
+type SearchProfilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	Favorited     string                 `protobuf:"bytes,3,opt,name=favorited,proto3" json:"favorited,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Autocomplete  bool                   `protobuf:"varint,2,opt,name=autocomplete,proto3" json:"autocomplete,omitempty"` // true 时只按用户名前缀匹配
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListArticlesRequest) Reset() {
-	*x = ListArticlesRequest{}
+func (x *SearchProfilesRequest) Reset() {
+	*x = SearchProfilesRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListArticlesRequest) String() string {
+func (x *SearchProfilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListArticlesRequest) ProtoMessage() {}
+func (*SearchProfilesRequest) ProtoMessage() {}
 
-func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchProfilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -279,68 +289,64 @@ func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
-func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchProfilesRequest.ProtoReflect.Descriptor instead.
+func (*SearchProfilesRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListArticlesRequest) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *ListArticlesRequest) GetAuthor() string {
+func (x *SearchProfilesRequest) GetQ() string {
 	if x != nil {
-		return x.Author
+		return x.Q
 	}
 	return ""
 }
 
-func (x *ListArticlesRequest) GetFavorited() string {
+func (x *SearchProfilesRequest) GetAutocomplete() bool {
 	if x != nil {
-		return x.Favorited
+		return x.Autocomplete
 	}
-	return ""
+	return false
 }
 
-func (x *ListArticlesRequest) GetLimit() int32 {
+func (x *SearchProfilesRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *ListArticlesRequest) GetOffset() int32 {
+func (x *SearchProfilesRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type FeedArticlesRequest struct {
+// 列表分页：cursor 为上一页返回的 next_cursor，传了 cursor 时忽略 offset
+type ListFollowersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeedArticlesRequest) Reset() {
-	*x = FeedArticlesRequest{}
+func (x *ListFollowersRequest) Reset() {
+	*x = ListFollowersRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeedArticlesRequest) String() string {
+func (x *ListFollowersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedArticlesRequest) ProtoMessage() {}
+func (*ListFollowersRequest) ProtoMessage() {}
 
-func (x *FeedArticlesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,46 +358,60 @@ func (x *FeedArticlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedArticlesRequest.ProtoReflect.Descriptor instead.
-func (*FeedArticlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *FeedArticlesRequest) GetLimit() int32 {
+func (x *ListFollowersRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListFollowersRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *FeedArticlesRequest) GetOffset() int32 {
+func (x *ListFollowersRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type GetArticleRequest struct {
+func (x *ListFollowersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListSuggestionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetArticleRequest) Reset() {
-	*x = GetArticleRequest{}
+func (x *ListSuggestionsRequest) Reset() {
+	*x = ListSuggestionsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetArticleRequest) String() string {
+func (x *ListSuggestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleRequest) ProtoMessage() {}
+func (*ListSuggestionsRequest) ProtoMessage() {}
 
-func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,39 +423,44 @@ func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
-func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuggestionsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetArticleRequest) GetSlug() string {
+func (x *ListSuggestionsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Slug
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type DeleteArticleRequest struct {
+type ListArticlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Favorited     string                 `protobuf:"bytes,3,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteArticleRequest) Reset() {
-	*x = DeleteArticleRequest{}
+func (x *ListArticlesRequest) Reset() {
+	*x = ListArticlesRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteArticleRequest) String() string {
+func (x *ListArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticleRequest) ProtoMessage() {}
+func (*ListArticlesRequest) ProtoMessage() {}
 
-func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,39 +472,76 @@ func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteArticleRequest) GetSlug() string {
+func (x *ListArticlesRequest) GetTag() string {
 	if x != nil {
-		return x.Slug
+		return x.Tag
 	}
 	return ""
 }
 
-type CreateArticleRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Article       *CreateArticleRequest_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+func (x *ListArticlesRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetFavorited() string {
+	if x != nil {
+		return x.Favorited
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type FeedArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleRequest) Reset() {
-	*x = CreateArticleRequest{}
+func (x *FeedArticlesRequest) Reset() {
+	*x = FeedArticlesRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleRequest) String() string {
+func (x *FeedArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleRequest) ProtoMessage() {}
+func (*FeedArticlesRequest) ProtoMessage() {}
 
-func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *FeedArticlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,40 +553,54 @@ func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
-func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeedArticlesRequest.ProtoReflect.Descriptor instead.
+func (*FeedArticlesRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateArticleRequest) GetArticle() *CreateArticleRequest_Article {
+func (x *FeedArticlesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Article
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type UpdateArticleRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Slug          string                        `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Article       *UpdateArticleRequest_Article `protobuf:"bytes,2,opt,name=article,proto3" json:"article,omitempty"`
+func (x *FeedArticlesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FeedArticlesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type RelatedArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleRequest) Reset() {
-	*x = UpdateArticleRequest{}
+func (x *RelatedArticlesRequest) Reset() {
+	*x = RelatedArticlesRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleRequest) String() string {
+func (x *RelatedArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleRequest) ProtoMessage() {}
+func (*RelatedArticlesRequest) ProtoMessage() {}
 
-func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *RelatedArticlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,47 +612,47 @@ func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelatedArticlesRequest.ProtoReflect.Descriptor instead.
+func (*RelatedArticlesRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateArticleRequest) GetSlug() string {
+func (x *RelatedArticlesRequest) GetSlug() string {
 	if x != nil {
 		return x.Slug
 	}
 	return ""
 }
 
-func (x *UpdateArticleRequest) GetArticle() *UpdateArticleRequest_Article {
+func (x *RelatedArticlesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Article
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type AddCommentsRequest struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Slug          string                      `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Comment       *AddCommentsRequest_Comment `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+type TrendingArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCommentsRequest) Reset() {
-	*x = AddCommentsRequest{}
+func (x *TrendingArticlesRequest) Reset() {
+	*x = TrendingArticlesRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCommentsRequest) String() string {
+func (x *TrendingArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentsRequest) ProtoMessage() {}
+func (*TrendingArticlesRequest) ProtoMessage() {}
 
-func (x *AddCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *TrendingArticlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -588,46 +664,48 @@ func (x *AddCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentsRequest.ProtoReflect.Descriptor instead.
-func (*AddCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrendingArticlesRequest.ProtoReflect.Descriptor instead.
+func (*TrendingArticlesRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AddCommentsRequest) GetSlug() string {
+func (x *TrendingArticlesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Slug
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *AddCommentsRequest) GetComment() *AddCommentsRequest_Comment {
+func (x *TrendingArticlesRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Comment
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type GetCommentsRequest struct {
+type ListDraftsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsRequest) Reset() {
-	*x = GetCommentsRequest{}
+func (x *ListDraftsRequest) Reset() {
+	*x = ListDraftsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsRequest) String() string {
+func (x *ListDraftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsRequest) ProtoMessage() {}
+func (*ListDraftsRequest) ProtoMessage() {}
 
-func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDraftsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,40 +717,57 @@ func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetCommentsRequest) GetSlug() string {
+func (x *ListDraftsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Slug
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDraftsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListDraftsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
 
-type DeleteCommentRequest struct {
+type SearchArticlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
+func (x *SearchArticlesRequest) Reset() {
+	*x = SearchArticlesRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *SearchArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*SearchArticlesRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchArticlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,46 +779,68 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchArticlesRequest.ProtoReflect.Descriptor instead.
+func (*SearchArticlesRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeleteCommentRequest) GetSlug() string {
+func (x *SearchArticlesRequest) GetQ() string {
 	if x != nil {
-		return x.Slug
+		return x.Q
 	}
 	return ""
 }
 
-func (x *DeleteCommentRequest) GetId() int32 {
+func (x *SearchArticlesRequest) GetTag() string {
 	if x != nil {
-		return x.Id
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-type FavoriteArticleRequest struct {
+func (x *SearchArticlesRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchArticlesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Html          bool                   `protobuf:"varint,2,opt,name=html,proto3" json:"html,omitempty"` // 为 true 时返回渲染并过滤后的 bodyHtml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FavoriteArticleRequest) Reset() {
-	*x = FavoriteArticleRequest{}
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FavoriteArticleRequest) String() string {
+func (x *GetArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteArticleRequest) ProtoMessage() {}
+func (*GetArticleRequest) ProtoMessage() {}
 
-func (x *FavoriteArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,39 +852,46 @@ func (x *FavoriteArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteArticleRequest.ProtoReflect.Descriptor instead.
-func (*FavoriteArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FavoriteArticleRequest) GetSlug() string {
+func (x *GetArticleRequest) GetSlug() string {
 	if x != nil {
 		return x.Slug
 	}
 	return ""
 }
 
-type UserReply struct {
+func (x *GetArticleRequest) GetHtml() bool {
+	if x != nil {
+		return x.Html
+	}
+	return false
+}
+
+type DeleteArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserReply_User        `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserReply) Reset() {
-	*x = UserReply{}
+func (x *DeleteArticleRequest) Reset() {
+	*x = DeleteArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserReply) String() string {
+func (x *DeleteArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserReply) ProtoMessage() {}
+func (*DeleteArticleRequest) ProtoMessage() {}
 
-func (x *UserReply) ProtoReflect() protoreflect.Message {
+func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,39 +903,39 @@ func (x *UserReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserReply.ProtoReflect.Descriptor instead.
-func (*UserReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UserReply) GetUser() *UserReply_User {
+func (x *DeleteArticleRequest) GetSlug() string {
 	if x != nil {
-		return x.User
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type ProfileReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *ProfileReply_Profile  `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Article       *CreateArticleRequest_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileReply) Reset() {
-	*x = ProfileReply{}
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileReply) String() string {
+func (x *CreateArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileReply) ProtoMessage() {}
+func (*CreateArticleRequest) ProtoMessage() {}
 
-func (x *ProfileReply) ProtoReflect() protoreflect.Message {
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,39 +947,42 @@ func (x *ProfileReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileReply.ProtoReflect.Descriptor instead.
-func (*ProfileReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ProfileReply) GetProfile() *ProfileReply_Profile {
+func (x *CreateArticleRequest) GetArticle() *CreateArticleRequest_Article {
 	if x != nil {
-		return x.Profile
+		return x.Article
 	}
 	return nil
 }
 
-type SingleArticleReply struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Article       *SingleArticleReply_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+type UpdateArticleRequest struct {
+	state   protoimpl.MessageState        `protogen:"open.v1"`
+	Slug    string                        `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Article *UpdateArticleRequest_Article `protobuf:"bytes,2,opt,name=article,proto3" json:"article,omitempty"`
+	// 要更新的 article 字段，规则同 UpdateUserRequest.update_mask
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleArticleReply) Reset() {
-	*x = SingleArticleReply{}
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleArticleReply) String() string {
+func (x *UpdateArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleArticleReply) ProtoMessage() {}
+func (*UpdateArticleRequest) ProtoMessage() {}
 
-func (x *SingleArticleReply) ProtoReflect() protoreflect.Message {
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,40 +994,55 @@ func (x *SingleArticleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleArticleReply.ProtoReflect.Descriptor instead.
-func (*SingleArticleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SingleArticleReply) GetArticle() *SingleArticleReply_Article {
+func (x *UpdateArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetArticle() *UpdateArticleRequest_Article {
 	if x != nil {
 		return x.Article
 	}
 	return nil
 }
 
-type MultipleArticleReply struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Articles      []*MultipleArticleReply_Article `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
-	ArticlesCount int32                           `protobuf:"varint,2,opt,name=articlesCount,proto3" json:"articlesCount,omitempty"`
+func (x *UpdateArticleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type AddCommentsRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Slug          string                      `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Comment       *AddCommentsRequest_Comment `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Html          bool                        `protobuf:"varint,3,opt,name=html,proto3" json:"html,omitempty"` // 为 true 时返回渲染并过滤后的 bodyHtml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleArticleReply) Reset() {
-	*x = MultipleArticleReply{}
+func (x *AddCommentsRequest) Reset() {
+	*x = AddCommentsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleArticleReply) String() string {
+func (x *AddCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleArticleReply) ProtoMessage() {}
+func (*AddCommentsRequest) ProtoMessage() {}
 
-func (x *MultipleArticleReply) ProtoReflect() protoreflect.Message {
+func (x *AddCommentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,46 +1054,58 @@ func (x *MultipleArticleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleArticleReply.ProtoReflect.Descriptor instead.
-func (*MultipleArticleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCommentsRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MultipleArticleReply) GetArticles() []*MultipleArticleReply_Article {
+func (x *AddCommentsRequest) GetSlug() string {
 	if x != nil {
-		return x.Articles
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *AddCommentsRequest) GetComment() *AddCommentsRequest_Comment {
+	if x != nil {
+		return x.Comment
 	}
 	return nil
 }
 
-func (x *MultipleArticleReply) GetArticlesCount() int32 {
+func (x *AddCommentsRequest) GetHtml() bool {
 	if x != nil {
-		return x.ArticlesCount
+		return x.Html
 	}
-	return 0
+	return false
 }
 
-type SingleCommentReply struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Comment       *SingleCommentReply_Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+type GetCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Html          bool                   `protobuf:"varint,5,opt,name=html,proto3" json:"html,omitempty"`         // 为 true 时返回渲染并过滤后的 bodyHtml
+	ParentId      int32                  `protobuf:"varint,6,opt,name=parentId,proto3" json:"parentId,omitempty"` // 为 0 时分页列出顶层评论，否则分页列出该评论的直接回复
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleCommentReply) Reset() {
-	*x = SingleCommentReply{}
+func (x *GetCommentsRequest) Reset() {
+	*x = GetCommentsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleCommentReply) String() string {
+func (x *GetCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleCommentReply) ProtoMessage() {}
+func (*GetCommentsRequest) ProtoMessage() {}
 
-func (x *SingleCommentReply) ProtoReflect() protoreflect.Message {
+func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,39 +1117,77 @@ func (x *SingleCommentReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleCommentReply.ProtoReflect.Descriptor instead.
-func (*SingleCommentReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SingleCommentReply) GetComment() *SingleCommentReply_Comment {
+func (x *GetCommentsRequest) GetSlug() string {
 	if x != nil {
-		return x.Comment
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type MultipleCommentReply struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Comments      []*MultipleCommentReply_Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+func (x *GetCommentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCommentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCommentsRequest) GetHtml() bool {
+	if x != nil {
+		return x.Html
+	}
+	return false
+}
+
+func (x *GetCommentsRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Slug          string                        `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            int32                         `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       *UpdateCommentRequest_Comment `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Html          bool                          `protobuf:"varint,4,opt,name=html,proto3" json:"html,omitempty"` // 为 true 时返回渲染并过滤后的 bodyHtml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleCommentReply) Reset() {
-	*x = MultipleCommentReply{}
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleCommentReply) String() string {
+func (x *UpdateCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleCommentReply) ProtoMessage() {}
+func (*UpdateCommentRequest) ProtoMessage() {}
 
-func (x *MultipleCommentReply) ProtoReflect() protoreflect.Message {
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,39 +1199,61 @@ func (x *MultipleCommentReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleCommentReply.ProtoReflect.Descriptor instead.
-func (*MultipleCommentReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MultipleCommentReply) GetComments() []*MultipleCommentReply_Comment {
+func (x *UpdateCommentRequest) GetSlug() string {
 	if x != nil {
-		return x.Comments
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCommentRequest) GetComment() *UpdateCommentRequest_Comment {
+	if x != nil {
+		return x.Comment
 	}
 	return nil
 }
 
-type ListTagsReply struct {
+func (x *UpdateCommentRequest) GetHtml() bool {
+	if x != nil {
+		return x.Html
+	}
+	return false
+}
+
+type ListCommentEditsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsReply) Reset() {
-	*x = ListTagsReply{}
+func (x *ListCommentEditsRequest) Reset() {
+	*x = ListCommentEditsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsReply) String() string {
+func (x *ListCommentEditsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsReply) ProtoMessage() {}
+func (*ListCommentEditsRequest) ProtoMessage() {}
 
-func (x *ListTagsReply) ProtoReflect() protoreflect.Message {
+func (x *ListCommentEditsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,40 +1265,47 @@ func (x *ListTagsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsReply.ProtoReflect.Descriptor instead.
-func (*ListTagsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCommentEditsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentEditsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListTagsReply) GetTags() []string {
+func (x *ListCommentEditsRequest) GetSlug() string {
 	if x != nil {
-		return x.Tags
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type AuthRequest_User struct {
+func (x *ListCommentEditsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthRequest_User) Reset() {
-	*x = AuthRequest_User{}
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthRequest_User) String() string {
+func (x *DeleteCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRequest_User) ProtoMessage() {}
+func (*DeleteCommentRequest) ProtoMessage() {}
 
-func (x *AuthRequest_User) ProtoReflect() protoreflect.Message {
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1096,48 +1317,46 @@ func (x *AuthRequest_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRequest_User.ProtoReflect.Descriptor instead.
-func (*AuthRequest_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AuthRequest_User) GetEmail() string {
+func (x *DeleteCommentRequest) GetSlug() string {
 	if x != nil {
-		return x.Email
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *AuthRequest_User) GetPassword() string {
+func (x *DeleteCommentRequest) GetId() int32 {
 	if x != nil {
-		return x.Password
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type RegisterRequest_User struct {
+type FavoriteArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterRequest_User) Reset() {
-	*x = RegisterRequest_User{}
+func (x *FavoriteArticleRequest) Reset() {
+	*x = FavoriteArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterRequest_User) String() string {
+func (x *FavoriteArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest_User) ProtoMessage() {}
+func (*FavoriteArticleRequest) ProtoMessage() {}
 
-func (x *RegisterRequest_User) ProtoReflect() protoreflect.Message {
+func (x *FavoriteArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1149,57 +1368,40 @@ func (x *RegisterRequest_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest_User.ProtoReflect.Descriptor instead.
-func (*RegisterRequest_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *RegisterRequest_User) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *RegisterRequest_User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use FavoriteArticleRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RegisterRequest_User) GetPassword() string {
+func (x *FavoriteArticleRequest) GetSlug() string {
 	if x != nil {
-		return x.Password
+		return x.Slug
 	}
 	return ""
 }
 
-type UpdateUserRequest_User struct {
+type ArticleReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"` // 配置中的表态之一，例如 like、insightful、funny
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest_User) Reset() {
-	*x = UpdateUserRequest_User{}
+func (x *ArticleReactionRequest) Reset() {
+	*x = ArticleReactionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest_User) String() string {
+func (x *ArticleReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest_User) ProtoMessage() {}
+func (*ArticleReactionRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest_User) ProtoReflect() protoreflect.Message {
+func (x *ArticleReactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1211,70 +1413,48 @@ func (x *UpdateUserRequest_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest_User.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *UpdateUserRequest_User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest_User) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest_User) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use ArticleReactionRequest.ProtoReflect.Descriptor instead.
+func (*ArticleReactionRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateUserRequest_User) GetBio() string {
+func (x *ArticleReactionRequest) GetSlug() string {
 	if x != nil {
-		return x.Bio
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest_User) GetImage() string {
+func (x *ArticleReactionRequest) GetReaction() string {
 	if x != nil {
-		return x.Image
+		return x.Reaction
 	}
 	return ""
 }
 
-type CreateArticleRequest_Article struct {
+type CommentReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	TagList       []string               `protobuf:"bytes,4,rep,name=tagList,proto3" json:"tagList,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleRequest_Article) Reset() {
-	*x = CreateArticleRequest_Article{}
+func (x *CommentReactionRequest) Reset() {
+	*x = CommentReactionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleRequest_Article) String() string {
+func (x *CommentReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleRequest_Article) ProtoMessage() {}
+func (*CommentReactionRequest) ProtoMessage() {}
 
-func (x *CreateArticleRequest_Article) ProtoReflect() protoreflect.Message {
+func (x *CommentReactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1286,62 +1466,54 @@ func (x *CreateArticleRequest_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleRequest_Article.ProtoReflect.Descriptor instead.
-func (*CreateArticleRequest_Article) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use CommentReactionRequest.ProtoReflect.Descriptor instead.
+func (*CommentReactionRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateArticleRequest_Article) GetTitle() string {
+func (x *CommentReactionRequest) GetSlug() string {
 	if x != nil {
-		return x.Title
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest_Article) GetDescription() string {
+func (x *CommentReactionRequest) GetId() int32 {
 	if x != nil {
-		return x.Description
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateArticleRequest_Article) GetBody() string {
+func (x *CommentReactionRequest) GetReaction() string {
 	if x != nil {
-		return x.Body
+		return x.Reaction
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest_Article) GetTagList() []string {
-	if x != nil {
-		return x.TagList
-	}
-	return nil
-}
-
-type UpdateArticleRequest_Article struct {
+type PublishArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Unlisted      bool                   `protobuf:"varint,2,opt,name=unlisted,proto3" json:"unlisted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleRequest_Article) Reset() {
-	*x = UpdateArticleRequest_Article{}
+func (x *PublishArticleRequest) Reset() {
+	*x = PublishArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleRequest_Article) String() string {
+func (x *PublishArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleRequest_Article) ProtoMessage() {}
+func (*PublishArticleRequest) ProtoMessage() {}
 
-func (x *UpdateArticleRequest_Article) ProtoReflect() protoreflect.Message {
+func (x *PublishArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1353,53 +1525,47 @@ func (x *UpdateArticleRequest_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleRequest_Article.ProtoReflect.Descriptor instead.
-func (*UpdateArticleRequest_Article) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{10, 0}
-}
-
-func (x *UpdateArticleRequest_Article) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use PublishArticleRequest.ProtoReflect.Descriptor instead.
+func (*PublishArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateArticleRequest_Article) GetDescription() string {
+func (x *PublishArticleRequest) GetSlug() string {
 	if x != nil {
-		return x.Description
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *UpdateArticleRequest_Article) GetBody() string {
+func (x *PublishArticleRequest) GetUnlisted() bool {
 	if x != nil {
-		return x.Body
+		return x.Unlisted
 	}
-	return ""
+	return false
 }
 
-type AddCommentsRequest_Comment struct {
+type ScheduleArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	ScheduledAt   string                 `protobuf:"bytes,2,opt,name=scheduledAt,proto3" json:"scheduledAt,omitempty"` // RFC 3339，例如 2026-10-26T09:00:00+08:00
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCommentsRequest_Comment) Reset() {
-	*x = AddCommentsRequest_Comment{}
+func (x *ScheduleArticleRequest) Reset() {
+	*x = ScheduleArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCommentsRequest_Comment) String() string {
+func (x *ScheduleArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentsRequest_Comment) ProtoMessage() {}
+func (*ScheduleArticleRequest) ProtoMessage() {}
 
-func (x *AddCommentsRequest_Comment) ProtoReflect() protoreflect.Message {
+func (x *ScheduleArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1411,43 +1577,46 @@ func (x *AddCommentsRequest_Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentsRequest_Comment.ProtoReflect.Descriptor instead.
-func (*AddCommentsRequest_Comment) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use ScheduleArticleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AddCommentsRequest_Comment) GetBody() string {
+func (x *ScheduleArticleRequest) GetSlug() string {
 	if x != nil {
-		return x.Body
+		return x.Slug
 	}
 	return ""
 }
 
-type UserReply_User struct {
+func (x *ScheduleArticleRequest) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+type ArticleStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserReply_User) Reset() {
-	*x = UserReply_User{}
+func (x *ArticleStatusRequest) Reset() {
+	*x = ArticleStatusRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserReply_User) String() string {
+func (x *ArticleStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserReply_User) ProtoMessage() {}
+func (*ArticleStatusRequest) ProtoMessage() {}
 
-func (x *UserReply_User) ProtoReflect() protoreflect.Message {
+func (x *ArticleStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1459,70 +1628,42 @@ func (x *UserReply_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserReply_User.ProtoReflect.Descriptor instead.
-func (*UserReply_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{15, 0}
-}
-
-func (x *UserReply_User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UserReply_User) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *UserReply_User) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UserReply_User) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
+// Deprecated: Use ArticleStatusRequest.ProtoReflect.Descriptor instead.
+func (*ArticleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UserReply_User) GetImage() string {
+func (x *ArticleStatusRequest) GetSlug() string {
 	if x != nil {
-		return x.Image
+		return x.Slug
 	}
 	return ""
 }
 
-type ProfileReply_Profile struct {
+type ListNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Unread        bool                   `protobuf:"varint,4,opt,name=unread,proto3" json:"unread,omitempty"` // 为 true 时只返回未读通知
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileReply_Profile) Reset() {
-	*x = ProfileReply_Profile{}
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileReply_Profile) String() string {
+func (x *ListNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileReply_Profile) ProtoMessage() {}
+func (*ListNotificationsRequest) ProtoMessage() {}
 
-func (x *ProfileReply_Profile) ProtoReflect() protoreflect.Message {
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1534,9 +1675,2579 @@ func (x *ProfileReply_Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileReply_Profile.ProtoReflect.Descriptor instead.
-func (*ProfileReply_Profile) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnread() bool {
+	if x != nil {
+		return x.Unread
+	}
+	return false
+}
+
+type MarkNotificationReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadRequest) Reset() {
+	*x = MarkNotificationReadRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MarkNotificationReadRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"` // 例如 ?usernames=alice&usernames=bob，一次最多 100 个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceRequest) Reset() {
+	*x = GetPresenceRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceRequest) ProtoMessage() {}
+
+func (x *GetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPresenceRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type UpdatePresenceSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hidden        bool                   `protobuf:"varint,1,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePresenceSettingsRequest) Reset() {
+	*x = UpdatePresenceSettingsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePresenceSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresenceSettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePresenceSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresenceSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePresenceSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdatePresenceSettingsRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Webhook       *CreateWebhookRequest_Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateWebhookRequest) GetWebhook() *CreateWebhookRequest_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteWebhookRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending / delivered / dead，为空表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       string                 `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`         // 文章 slug，不为空时同时推送这篇文章的新评论
+	LastEventId   string                 `protobuf:"bytes,2,opt,name=lastEventId,proto3" json:"lastEventId,omitempty"` // 重新订阅时传最后收到的事件 id，先补发之后的事件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubscribeRequest) GetArticle() string {
+	if x != nil {
+		return x.Article
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListRevisionsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionRequest) Reset() {
+	*x = GetRevisionRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionRequest) ProtoMessage() {}
+
+func (x *GetRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRevisionRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RestoreRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 期望的当前版本，也可以用 If-Match 头传
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRevisionRequest) Reset() {
+	*x = RestoreRevisionRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreRevisionRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RestoreRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RestoreRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DiffRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	From          int32                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRevisionsRequest) Reset() {
+	*x = DiffRevisionsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DiffRevisionsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *DiffRevisionsRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffRevisionsRequest) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type UserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserReply_User        `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReply) Reset() {
+	*x = UserReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReply) ProtoMessage() {}
+
+func (x *UserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReply.ProtoReflect.Descriptor instead.
+func (*UserReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UserReply) GetUser() *UserReply_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ProfileReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *ProfileReply_Profile  `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileReply) Reset() {
+	*x = ProfileReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileReply) ProtoMessage() {}
+
+func (x *ProfileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileReply.ProtoReflect.Descriptor instead.
+func (*ProfileReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ProfileReply) GetProfile() *ProfileReply_Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type MultipleProfileReply struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Profiles      []*MultipleProfileReply_Profile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	NextCursor    string                          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleProfileReply) Reset() {
+	*x = MultipleProfileReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleProfileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleProfileReply) ProtoMessage() {}
+
+func (x *MultipleProfileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleProfileReply.ProtoReflect.Descriptor instead.
+func (*MultipleProfileReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MultipleProfileReply) GetProfiles() []*MultipleProfileReply_Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *MultipleProfileReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 一种表态的汇总，按配置的顺序列出所有表态
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reaction      string                 `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Reacted       bool                   `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"` // 当前用户是否做过这种表态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Reaction) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetReacted() bool {
+	if x != nil {
+		return x.Reacted
+	}
+	return false
+}
+
+type ReactionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionsReply) Reset() {
+	*x = ReactionsReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionsReply) ProtoMessage() {}
+
+func (x *ReactionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionsReply.ProtoReflect.Descriptor instead.
+func (*ReactionsReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReactionsReply) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// 正文中的一处 @提及，start/end 为 Unicode 字符下标，左闭右开，包含 @
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 被提及用户当前的用户名
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`      // 在正文中的 UTF-16 下标，和 JavaScript 字符串一致，包含 @
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`          // 不包含
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Mention) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Mention) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Mention) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SingleArticleReply struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Article       *SingleArticleReply_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleArticleReply) Reset() {
+	*x = SingleArticleReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleArticleReply) ProtoMessage() {}
+
+func (x *SingleArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleArticleReply.ProtoReflect.Descriptor instead.
+func (*SingleArticleReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SingleArticleReply) GetArticle() *SingleArticleReply_Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type MultipleArticleReply struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Articles      []*MultipleArticleReply_Article `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	ArticlesCount int32                           `protobuf:"varint,2,opt,name=articlesCount,proto3" json:"articlesCount,omitempty"`
+	NextCursor    string                          `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleArticleReply) Reset() {
+	*x = MultipleArticleReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleArticleReply) ProtoMessage() {}
+
+func (x *MultipleArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleArticleReply.ProtoReflect.Descriptor instead.
+func (*MultipleArticleReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MultipleArticleReply) GetArticles() []*MultipleArticleReply_Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *MultipleArticleReply) GetArticlesCount() int32 {
+	if x != nil {
+		return x.ArticlesCount
+	}
+	return 0
+}
+
+func (x *MultipleArticleReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SearchArticlesReply struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Articles      []*SearchArticlesReply_Article `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	ArticlesCount int32                          `protobuf:"varint,2,opt,name=articlesCount,proto3" json:"articlesCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArticlesReply) Reset() {
+	*x = SearchArticlesReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesReply) ProtoMessage() {}
+
+func (x *SearchArticlesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesReply.ProtoReflect.Descriptor instead.
+func (*SearchArticlesReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchArticlesReply) GetArticles() []*SearchArticlesReply_Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *SearchArticlesReply) GetArticlesCount() int32 {
+	if x != nil {
+		return x.ArticlesCount
+	}
+	return 0
+}
+
+type SingleRevisionReply struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Revision      *SingleRevisionReply_Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleRevisionReply) Reset() {
+	*x = SingleRevisionReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleRevisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleRevisionReply) ProtoMessage() {}
+
+func (x *SingleRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleRevisionReply.ProtoReflect.Descriptor instead.
+func (*SingleRevisionReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SingleRevisionReply) GetRevision() *SingleRevisionReply_Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type MultipleRevisionReply struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Revisions     []*MultipleRevisionReply_Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextCursor    string                            `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleRevisionReply) Reset() {
+	*x = MultipleRevisionReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleRevisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleRevisionReply) ProtoMessage() {}
+
+func (x *MultipleRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleRevisionReply.ProtoReflect.Descriptor instead.
+func (*MultipleRevisionReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MultipleRevisionReply) GetRevisions() []*MultipleRevisionReply_Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *MultipleRevisionReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type RevisionDiffReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Diff          string                 `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"` // 标题、摘要、正文各自一段，未变化的字段省略
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevisionDiffReply) Reset() {
+	*x = RevisionDiffReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevisionDiffReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionDiffReply) ProtoMessage() {}
+
+func (x *RevisionDiffReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionDiffReply.ProtoReflect.Descriptor instead.
+func (*RevisionDiffReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevisionDiffReply) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *RevisionDiffReply) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *RevisionDiffReply) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type SingleCommentReply struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Comment       *SingleCommentReply_Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Slug          string                      `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"` // 文章当前的 slug，用旧 slug 请求时与请求中的不同
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleCommentReply) Reset() {
+	*x = SingleCommentReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleCommentReply) ProtoMessage() {}
+
+func (x *SingleCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleCommentReply.ProtoReflect.Descriptor instead.
+func (*SingleCommentReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SingleCommentReply) GetComment() *SingleCommentReply_Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *SingleCommentReply) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type MultipleCommentReply struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Comments      []*MultipleCommentReply_Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor    string                          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Slug          string                          `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"` // 文章当前的 slug，用旧 slug 请求时与请求中的不同
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleCommentReply) Reset() {
+	*x = MultipleCommentReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleCommentReply) ProtoMessage() {}
+
+func (x *MultipleCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleCommentReply.ProtoReflect.Descriptor instead.
+func (*MultipleCommentReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MultipleCommentReply) GetComments() []*MultipleCommentReply_Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *MultipleCommentReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *MultipleCommentReply) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type MultipleCommentEditReply struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Edits         []*MultipleCommentEditReply_Edit `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"` // 从新到旧
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleCommentEditReply) Reset() {
+	*x = MultipleCommentEditReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleCommentEditReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleCommentEditReply) ProtoMessage() {}
+
+func (x *MultipleCommentEditReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleCommentEditReply.ProtoReflect.Descriptor instead.
+func (*MultipleCommentEditReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MultipleCommentEditReply) GetEdits() []*MultipleCommentEditReply_Edit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+type MultipleNotificationReply struct {
+	state         protoimpl.MessageState                    `protogen:"open.v1"`
+	Notifications []*MultipleNotificationReply_Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextCursor    string                                    `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleNotificationReply) Reset() {
+	*x = MultipleNotificationReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleNotificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleNotificationReply) ProtoMessage() {}
+
+func (x *MultipleNotificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleNotificationReply.ProtoReflect.Descriptor instead.
+func (*MultipleNotificationReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MultipleNotificationReply) GetNotifications() []*MultipleNotificationReply_Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *MultipleNotificationReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UnreadCountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadCountReply) Reset() {
+	*x = UnreadCountReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountReply) ProtoMessage() {}
+
+func (x *UnreadCountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountReply.ProtoReflect.Descriptor instead.
+func (*UnreadCountReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UnreadCountReply) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LiveTicketReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`        // 只能用一次，很快过期；长期有效的 token 不会出现在 URL 和访问日志里
+	ExpiresIn     int32                  `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"` // 有效期，秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveTicketReply) Reset() {
+	*x = LiveTicketReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveTicketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveTicketReply) ProtoMessage() {}
+
+func (x *LiveTicketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveTicketReply.ProtoReflect.Descriptor instead.
+func (*LiveTicketReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LiveTicketReply) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *LiveTicketReply) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type MultiplePresenceReply struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Presences     []*MultiplePresenceReply_Presence `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty"` // 不存在的用户名不返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiplePresenceReply) Reset() {
+	*x = MultiplePresenceReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiplePresenceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiplePresenceReply) ProtoMessage() {}
+
+func (x *MultiplePresenceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiplePresenceReply.ProtoReflect.Descriptor instead.
+func (*MultiplePresenceReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MultiplePresenceReply) GetPresences() []*MultiplePresenceReply_Presence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+type PresenceSettingsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hidden        bool                   `protobuf:"varint,1,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceSettingsReply) Reset() {
+	*x = PresenceSettingsReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceSettingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceSettingsReply) ProtoMessage() {}
+
+func (x *PresenceSettingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceSettingsReply.ProtoReflect.Descriptor instead.
+func (*PresenceSettingsReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PresenceSettingsReply) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type WebhookReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *WebhookReply_Webhook  `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookReply) Reset() {
+	*x = WebhookReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookReply) ProtoMessage() {}
+
+func (x *WebhookReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookReply.ProtoReflect.Descriptor instead.
+func (*WebhookReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WebhookReply) GetWebhook() *WebhookReply_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type MultipleWebhookReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Webhooks      []*WebhookReply_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleWebhookReply) Reset() {
+	*x = MultipleWebhookReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleWebhookReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleWebhookReply) ProtoMessage() {}
+
+func (x *MultipleWebhookReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleWebhookReply.ProtoReflect.Descriptor instead.
+func (*MultipleWebhookReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MultipleWebhookReply) GetWebhooks() []*WebhookReply_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type MultipleWebhookDeliveryReply struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Deliveries    []*MultipleWebhookDeliveryReply_Delivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextCursor    string                                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleWebhookDeliveryReply) Reset() {
+	*x = MultipleWebhookDeliveryReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleWebhookDeliveryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleWebhookDeliveryReply) ProtoMessage() {}
+
+func (x *MultipleWebhookDeliveryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleWebhookDeliveryReply.ProtoReflect.Descriptor instead.
+func (*MultipleWebhookDeliveryReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MultipleWebhookDeliveryReply) GetDeliveries() []*MultipleWebhookDeliveryReply_Delivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *MultipleWebhookDeliveryReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type LiveEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 用作重新订阅时的 lastEventId
+	// Types that are valid to be assigned to Event:
+	//
+	//	*LiveEvent_Comment_
+	//	*LiveEvent_Notification_
+	//	*LiveEvent_Feed_
+	//	*LiveEvent_Presence_
+	Event         isLiveEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveEvent) Reset() {
+	*x = LiveEvent{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveEvent) ProtoMessage() {}
+
+func (x *LiveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveEvent.ProtoReflect.Descriptor instead.
+func (*LiveEvent) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LiveEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LiveEvent) GetEvent() isLiveEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetComment() *LiveEvent_Comment {
+	if x != nil {
+		if x, ok := x.Event.(*LiveEvent_Comment_); ok {
+			return x.Comment
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetNotification() *LiveEvent_Notification {
+	if x != nil {
+		if x, ok := x.Event.(*LiveEvent_Notification_); ok {
+			return x.Notification
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetFeed() *LiveEvent_Feed {
+	if x != nil {
+		if x, ok := x.Event.(*LiveEvent_Feed_); ok {
+			return x.Feed
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetPresence() *LiveEvent_Presence {
+	if x != nil {
+		if x, ok := x.Event.(*LiveEvent_Presence_); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+type isLiveEvent_Event interface {
+	isLiveEvent_Event()
+}
+
+type LiveEvent_Comment_ struct {
+	Comment *LiveEvent_Comment `protobuf:"bytes,2,opt,name=comment,proto3,oneof"`
+}
+
+type LiveEvent_Notification_ struct {
+	Notification *LiveEvent_Notification `protobuf:"bytes,3,opt,name=notification,proto3,oneof"`
+}
+
+type LiveEvent_Feed_ struct {
+	Feed *LiveEvent_Feed `protobuf:"bytes,4,opt,name=feed,proto3,oneof"`
+}
+
+type LiveEvent_Presence_ struct {
+	Presence *LiveEvent_Presence `protobuf:"bytes,5,opt,name=presence,proto3,oneof"`
+}
+
+func (*LiveEvent_Comment_) isLiveEvent_Event() {}
+
+func (*LiveEvent_Notification_) isLiveEvent_Event() {}
+
+func (*LiveEvent_Feed_) isLiveEvent_Event() {}
+
+func (*LiveEvent_Presence_) isLiveEvent_Event() {}
+
+type ListTagsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsReply) Reset() {
+	*x = ListTagsReply{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsReply) ProtoMessage() {}
+
+func (x *ListTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsReply.ProtoReflect.Descriptor instead.
+func (*ListTagsReply) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListTagsReply) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AuthRequest_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthRequest_User) Reset() {
+	*x = AuthRequest_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRequest_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRequest_User) ProtoMessage() {}
+
+func (x *AuthRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRequest_User.ProtoReflect.Descriptor instead.
+func (*AuthRequest_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AuthRequest_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthRequest_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterRequest_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest_User) Reset() {
+	*x = RegisterRequest_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest_User) ProtoMessage() {}
+
+func (x *RegisterRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest_User.ProtoReflect.Descriptor instead.
+func (*RegisterRequest_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *RegisterRequest_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UpdateUserRequest_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"` // 期望的当前版本，也可以用 If-Match 头传
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest_User) Reset() {
+	*x = UpdateUserRequest_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest_User) ProtoMessage() {}
+
+func (x *UpdateUserRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest_User.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *UpdateUserRequest_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CreateArticleRequest_Article struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	TagList       []string               `protobuf:"bytes,4,rep,name=tagList,proto3" json:"tagList,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // draft / published / unlisted，默认 published
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest_Article) Reset() {
+	*x = CreateArticleRequest_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest_Article) ProtoMessage() {}
+
+func (x *CreateArticleRequest_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest_Article.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *CreateArticleRequest_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest_Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest_Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *CreateArticleRequest_Article) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateArticleRequest_Article struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // 期望的当前版本，也可以用 If-Match 头传
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest_Article) Reset() {
+	*x = UpdateArticleRequest_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest_Article) ProtoMessage() {}
+
+func (x *UpdateArticleRequest_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest_Article.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *UpdateArticleRequest_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest_Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest_Article) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type AddCommentsRequest_Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	ParentId      int32                  `protobuf:"varint,2,opt,name=parentId,proto3" json:"parentId,omitempty"` // 回复的评论 id，为 0 时是顶层评论
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentsRequest_Comment) Reset() {
+	*x = AddCommentsRequest_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentsRequest_Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentsRequest_Comment) ProtoMessage() {}
+
+func (x *AddCommentsRequest_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentsRequest_Comment.ProtoReflect.Descriptor instead.
+func (*AddCommentsRequest_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *AddCommentsRequest_Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *AddCommentsRequest_Comment) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type UpdateCommentRequest_Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest_Comment) Reset() {
+	*x = UpdateCommentRequest_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest_Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest_Comment) ProtoMessage() {}
+
+func (x *UpdateCommentRequest_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest_Comment.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *UpdateCommentRequest_Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CreateWebhookRequest_Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // 为空时自动生成，只在创建时返回
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"` // article.published / article.updated / article.unpublished / article.deleted / comment.created，为空表示全部
+	All           bool                   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`      // 订阅全站的事件而不只是自己的文章，只有版主可以设置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest_Webhook) Reset() {
+	*x = CreateWebhookRequest_Webhook{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest_Webhook) ProtoMessage() {}
+
+func (x *CreateWebhookRequest_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest_Webhook.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest_Webhook) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *CreateWebhookRequest_Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest_Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest_Webhook) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type UserReply_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	HidePresence  bool                   `protobuf:"varint,7,opt,name=hidePresence,proto3" json:"hidePresence,omitempty"` // 是否对其他人隐藏了在线状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReply_User) Reset() {
+	*x = UserReply_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReply_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReply_User) ProtoMessage() {}
+
+func (x *UserReply_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReply_User.ProtoReflect.Descriptor instead.
+func (*UserReply_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *UserReply_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserReply_User) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UserReply_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserReply_User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserReply_User) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UserReply_User) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserReply_User) GetHidePresence() bool {
+	if x != nil {
+		return x.HidePresence
+	}
+	return false
+}
+
+type ProfileReply_Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	Online        bool                   `protobuf:"varint,5,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,6,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"` // 对方隐藏了在线状态时 online 为 false，lastSeenAt 为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileReply_Profile) Reset() {
+	*x = ProfileReply_Profile{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileReply_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileReply_Profile) ProtoMessage() {}
+
+func (x *ProfileReply_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileReply_Profile.ProtoReflect.Descriptor instead.
+func (*ProfileReply_Profile) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{42, 0}
 }
 
 func (x *ProfileReply_Profile) GetUsername() string {
@@ -1546,58 +4257,1355 @@ func (x *ProfileReply_Profile) GetUsername() string {
 	return ""
 }
 
-func (x *ProfileReply_Profile) GetBio() string {
+func (x *ProfileReply_Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *ProfileReply_Profile) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ProfileReply_Profile) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *ProfileReply_Profile) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *ProfileReply_Profile) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+type MultipleProfileReply_Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleProfileReply_Profile) Reset() {
+	*x = MultipleProfileReply_Profile{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleProfileReply_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleProfileReply_Profile) ProtoMessage() {}
+
+func (x *MultipleProfileReply_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleProfileReply_Profile.ProtoReflect.Descriptor instead.
+func (*MultipleProfileReply_Profile) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *MultipleProfileReply_Profile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MultipleProfileReply_Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *MultipleProfileReply_Profile) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *MultipleProfileReply_Profile) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type SingleArticleReply_Article struct {
+	state          protoimpl.MessageState                `protogen:"open.v1"`
+	Slug           string                                `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title          string                                `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                                `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Body           string                                `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	TagList        []string                              `protobuf:"bytes,5,rep,name=tagList,proto3" json:"tagList,omitempty"`
+	CreatedAt      string                                `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      string                                `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Favorited      bool                                  `protobuf:"varint,8,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	FavoritesCount int32                                 `protobuf:"varint,9,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
+	Author         *SingleArticleReply_Article_Author    `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	Status         string                                `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	PublishedAt    string                                `protobuf:"bytes,12,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	ScheduledAt    string                                `protobuf:"bytes,13,opt,name=scheduledAt,proto3" json:"scheduledAt,omitempty"`
+	Version        int64                                 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	BodyHtml       string                                `protobuf:"bytes,15,opt,name=bodyHtml,proto3" json:"bodyHtml,omitempty"`
+	WordCount      int32                                 `protobuf:"varint,16,opt,name=wordCount,proto3" json:"wordCount,omitempty"`
+	ReadingTime    int32                                 `protobuf:"varint,17,opt,name=readingTime,proto3" json:"readingTime,omitempty"` // 预计阅读分钟数
+	Toc            []*SingleArticleReply_Article_Heading `protobuf:"bytes,18,rep,name=toc,proto3" json:"toc,omitempty"`
+	ViewsCount     int64                                 `protobuf:"varint,19,opt,name=viewsCount,proto3" json:"viewsCount,omitempty"` // 独立访客数，定期落库，略有延迟
+	Reactions      []*Reaction                           `protobuf:"bytes,20,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Mentions       []*Mention                            `protobuf:"bytes,21,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SingleArticleReply_Article) Reset() {
+	*x = SingleArticleReply_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleArticleReply_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleArticleReply_Article) ProtoMessage() {}
+
+func (x *SingleArticleReply_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleArticleReply_Article.ProtoReflect.Descriptor instead.
+func (*SingleArticleReply_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *SingleArticleReply_Article) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *SingleArticleReply_Article) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *SingleArticleReply_Article) GetFavoritesCount() int32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *SingleArticleReply_Article) GetAuthor() *SingleArticleReply_Article_Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *SingleArticleReply_Article) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SingleArticleReply_Article) GetBodyHtml() string {
+	if x != nil {
+		return x.BodyHtml
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *SingleArticleReply_Article) GetReadingTime() int32 {
+	if x != nil {
+		return x.ReadingTime
+	}
+	return 0
+}
+
+func (x *SingleArticleReply_Article) GetToc() []*SingleArticleReply_Article_Heading {
+	if x != nil {
+		return x.Toc
+	}
+	return nil
+}
+
+func (x *SingleArticleReply_Article) GetViewsCount() int64 {
+	if x != nil {
+		return x.ViewsCount
+	}
+	return 0
+}
+
+func (x *SingleArticleReply_Article) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *SingleArticleReply_Article) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type SingleArticleReply_Article_Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleArticleReply_Article_Author) Reset() {
+	*x = SingleArticleReply_Article_Author{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleArticleReply_Article_Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleArticleReply_Article_Author) ProtoMessage() {}
+
+func (x *SingleArticleReply_Article_Author) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleArticleReply_Article_Author.ProtoReflect.Descriptor instead.
+func (*SingleArticleReply_Article_Author) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{47, 0, 0}
+}
+
+func (x *SingleArticleReply_Article_Author) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article_Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article_Author) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article_Author) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type SingleArticleReply_Article_Heading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         int32                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"` // 与 bodyHtml 中标题的 id 一致，可直接作为锚点
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleArticleReply_Article_Heading) Reset() {
+	*x = SingleArticleReply_Article_Heading{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleArticleReply_Article_Heading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleArticleReply_Article_Heading) ProtoMessage() {}
+
+func (x *SingleArticleReply_Article_Heading) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleArticleReply_Article_Heading.ProtoReflect.Descriptor instead.
+func (*SingleArticleReply_Article_Heading) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{47, 0, 1}
+}
+
+func (x *SingleArticleReply_Article_Heading) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SingleArticleReply_Article_Heading) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SingleArticleReply_Article_Heading) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MultipleArticleReply_Article struct {
+	state          protoimpl.MessageState               `protogen:"open.v1"`
+	Slug           string                               `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title          string                               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TagList        []string                             `protobuf:"bytes,4,rep,name=tagList,proto3" json:"tagList,omitempty"`
+	CreatedAt      string                               `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      string                               `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Favorited      bool                                 `protobuf:"varint,7,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	FavoritesCount int32                                `protobuf:"varint,8,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
+	Author         *MultipleArticleReply_Article_Author `protobuf:"bytes,9,opt,name=author,proto3" json:"author,omitempty"`
+	ViewsCount     int64                                `protobuf:"varint,10,opt,name=viewsCount,proto3" json:"viewsCount,omitempty"`
+	Reactions      []*Reaction                          `protobuf:"bytes,11,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MultipleArticleReply_Article) Reset() {
+	*x = MultipleArticleReply_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleArticleReply_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleArticleReply_Article) ProtoMessage() {}
+
+func (x *MultipleArticleReply_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleArticleReply_Article.ProtoReflect.Descriptor instead.
+func (*MultipleArticleReply_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *MultipleArticleReply_Article) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *MultipleArticleReply_Article) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *MultipleArticleReply_Article) GetFavoritesCount() int32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *MultipleArticleReply_Article) GetAuthor() *MultipleArticleReply_Article_Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *MultipleArticleReply_Article) GetViewsCount() int64 {
+	if x != nil {
+		return x.ViewsCount
+	}
+	return 0
+}
+
+func (x *MultipleArticleReply_Article) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type MultipleArticleReply_Article_Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleArticleReply_Article_Author) Reset() {
+	*x = MultipleArticleReply_Article_Author{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleArticleReply_Article_Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleArticleReply_Article_Author) ProtoMessage() {}
+
+func (x *MultipleArticleReply_Article_Author) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleArticleReply_Article_Author.ProtoReflect.Descriptor instead.
+func (*MultipleArticleReply_Article_Author) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{48, 0, 0}
+}
+
+func (x *MultipleArticleReply_Article_Author) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article_Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article_Author) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *MultipleArticleReply_Article_Author) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type SearchArticlesReply_Article struct {
+	state          protoimpl.MessageState              `protogen:"open.v1"`
+	Slug           string                              `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title          string                              `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TagList        []string                            `protobuf:"bytes,4,rep,name=tagList,proto3" json:"tagList,omitempty"`
+	CreatedAt      string                              `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      string                              `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Favorited      bool                                `protobuf:"varint,7,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	FavoritesCount int32                               `protobuf:"varint,8,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
+	Author         *SearchArticlesReply_Article_Author `protobuf:"bytes,9,opt,name=author,proto3" json:"author,omitempty"`
+	TitleHighlight string                              `protobuf:"bytes,10,opt,name=titleHighlight,proto3" json:"titleHighlight,omitempty"` // 命中词用 <mark></mark> 包裹的标题，其余内容已做 HTML 转义
+	Snippet        string                              `protobuf:"bytes,11,opt,name=snippet,proto3" json:"snippet,omitempty"`               // 正文中命中词附近的摘要片段，格式同 titleHighlight
+	Rank           float32                             `protobuf:"fixed32,12,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchArticlesReply_Article) Reset() {
+	*x = SearchArticlesReply_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesReply_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesReply_Article) ProtoMessage() {}
+
+func (x *SearchArticlesReply_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesReply_Article.ProtoReflect.Descriptor instead.
+func (*SearchArticlesReply_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *SearchArticlesReply_Article) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *SearchArticlesReply_Article) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *SearchArticlesReply_Article) GetFavoritesCount() int32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *SearchArticlesReply_Article) GetAuthor() *SearchArticlesReply_Article_Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *SearchArticlesReply_Article) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SearchArticlesReply_Article_Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArticlesReply_Article_Author) Reset() {
+	*x = SearchArticlesReply_Article_Author{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesReply_Article_Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesReply_Article_Author) ProtoMessage() {}
+
+func (x *SearchArticlesReply_Article_Author) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesReply_Article_Author.ProtoReflect.Descriptor instead.
+func (*SearchArticlesReply_Article_Author) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{49, 0, 0}
+}
+
+func (x *SearchArticlesReply_Article_Author) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article_Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article_Author) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SearchArticlesReply_Article_Author) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type SingleRevisionReply_Revision struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Revision      int32                                `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title         string                               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Body          string                               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                               `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Editor        *SingleRevisionReply_Revision_Editor `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	RestoredFrom  int32                                `protobuf:"varint,7,opt,name=restoredFrom,proto3" json:"restoredFrom,omitempty"` // 由哪个版本恢复而来，0 表示普通修改
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleRevisionReply_Revision) Reset() {
+	*x = SingleRevisionReply_Revision{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleRevisionReply_Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleRevisionReply_Revision) ProtoMessage() {}
+
+func (x *SingleRevisionReply_Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleRevisionReply_Revision.ProtoReflect.Descriptor instead.
+func (*SingleRevisionReply_Revision) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *SingleRevisionReply_Revision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SingleRevisionReply_Revision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SingleRevisionReply_Revision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SingleRevisionReply_Revision) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SingleRevisionReply_Revision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SingleRevisionReply_Revision) GetEditor() *SingleRevisionReply_Revision_Editor {
+	if x != nil {
+		return x.Editor
+	}
+	return nil
+}
+
+func (x *SingleRevisionReply_Revision) GetRestoredFrom() int32 {
+	if x != nil {
+		return x.RestoredFrom
+	}
+	return 0
+}
+
+type SingleRevisionReply_Revision_Editor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleRevisionReply_Revision_Editor) Reset() {
+	*x = SingleRevisionReply_Revision_Editor{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleRevisionReply_Revision_Editor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleRevisionReply_Revision_Editor) ProtoMessage() {}
+
+func (x *SingleRevisionReply_Revision_Editor) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleRevisionReply_Revision_Editor.ProtoReflect.Descriptor instead.
+func (*SingleRevisionReply_Revision_Editor) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{50, 0, 0}
+}
+
+func (x *SingleRevisionReply_Revision_Editor) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SingleRevisionReply_Revision_Editor) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *SingleRevisionReply_Revision_Editor) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type MultipleRevisionReply_Revision struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Revision      int32                                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title         string                                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     string                                 `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Editor        *MultipleRevisionReply_Revision_Editor `protobuf:"bytes,5,opt,name=editor,proto3" json:"editor,omitempty"`
+	RestoredFrom  int32                                  `protobuf:"varint,6,opt,name=restoredFrom,proto3" json:"restoredFrom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleRevisionReply_Revision) Reset() {
+	*x = MultipleRevisionReply_Revision{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleRevisionReply_Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleRevisionReply_Revision) ProtoMessage() {}
+
+func (x *MultipleRevisionReply_Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleRevisionReply_Revision.ProtoReflect.Descriptor instead.
+func (*MultipleRevisionReply_Revision) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *MultipleRevisionReply_Revision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *MultipleRevisionReply_Revision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MultipleRevisionReply_Revision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MultipleRevisionReply_Revision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MultipleRevisionReply_Revision) GetEditor() *MultipleRevisionReply_Revision_Editor {
+	if x != nil {
+		return x.Editor
+	}
+	return nil
+}
+
+func (x *MultipleRevisionReply_Revision) GetRestoredFrom() int32 {
+	if x != nil {
+		return x.RestoredFrom
+	}
+	return 0
+}
+
+type MultipleRevisionReply_Revision_Editor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleRevisionReply_Revision_Editor) Reset() {
+	*x = MultipleRevisionReply_Revision_Editor{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleRevisionReply_Revision_Editor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleRevisionReply_Revision_Editor) ProtoMessage() {}
+
+func (x *MultipleRevisionReply_Revision_Editor) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleRevisionReply_Revision_Editor.ProtoReflect.Descriptor instead.
+func (*MultipleRevisionReply_Revision_Editor) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{51, 0, 0}
+}
+
+func (x *MultipleRevisionReply_Revision_Editor) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MultipleRevisionReply_Revision_Editor) GetBio() string {
 	if x != nil {
 		return x.Bio
 	}
 	return ""
 }
 
-func (x *ProfileReply_Profile) GetImage() string {
+func (x *MultipleRevisionReply_Revision_Editor) GetImage() string {
 	if x != nil {
 		return x.Image
 	}
 	return ""
 }
 
-func (x *ProfileReply_Profile) GetFollowing() bool {
+type SingleCommentReply_Comment struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Id            int32                              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                             `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     string                             `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Body          string                             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Author        *SingleCommentReply_Comment_Author `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	BodyHtml      string                             `protobuf:"bytes,6,opt,name=bodyHtml,proto3" json:"bodyHtml,omitempty"`
+	ParentId      int32                              `protobuf:"varint,7,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Depth         int32                              `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	Edited        bool                               `protobuf:"varint,9,opt,name=edited,proto3" json:"edited,omitempty"` // 发表后修改过，updatedAt 为最后一次修改的时间
+	Reactions     []*Reaction                        `protobuf:"bytes,10,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Mentions      []*Mention                         `protobuf:"bytes,11,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleCommentReply_Comment) Reset() {
+	*x = SingleCommentReply_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleCommentReply_Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleCommentReply_Comment) ProtoMessage() {}
+
+func (x *SingleCommentReply_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleCommentReply_Comment.ProtoReflect.Descriptor instead.
+func (*SingleCommentReply_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *SingleCommentReply_Comment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SingleCommentReply_Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment) GetAuthor() *SingleCommentReply_Comment_Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *SingleCommentReply_Comment) GetBodyHtml() string {
+	if x != nil {
+		return x.BodyHtml
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *SingleCommentReply_Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *SingleCommentReply_Comment) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *SingleCommentReply_Comment) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *SingleCommentReply_Comment) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type SingleCommentReply_Comment_Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleCommentReply_Comment_Author) Reset() {
+	*x = SingleCommentReply_Comment_Author{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleCommentReply_Comment_Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleCommentReply_Comment_Author) ProtoMessage() {}
+
+func (x *SingleCommentReply_Comment_Author) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleCommentReply_Comment_Author.ProtoReflect.Descriptor instead.
+func (*SingleCommentReply_Comment_Author) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{53, 0, 0}
+}
+
+func (x *SingleCommentReply_Comment_Author) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment_Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment_Author) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SingleCommentReply_Comment_Author) GetFollowing() bool {
 	if x != nil {
 		return x.Following
 	}
 	return false
 }
 
-type SingleArticleReply_Article struct {
-	state          protoimpl.MessageState             `protogen:"open.v1"`
-	Slug           string                             `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title          string                             `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Body           string                             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	TagList        []string                           `protobuf:"bytes,5,rep,name=tagList,proto3" json:"tagList,omitempty"`
-	CreatedAt      string                             `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt      string                             `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Favorited      bool                               `protobuf:"varint,8,opt,name=favorited,proto3" json:"favorited,omitempty"`
-	FavoritesCount int32                              `protobuf:"varint,9,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
-	Author         *SingleArticleReply_Article_Author `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type MultipleCommentReply_Comment struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Id            int32                                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                               `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     string                               `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Body          string                               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Author        *MultipleCommentReply_Comment_Author `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"` // 已删除的评论不返回作者
+	BodyHtml      string                               `protobuf:"bytes,6,opt,name=bodyHtml,proto3" json:"bodyHtml,omitempty"`
+	ParentId      int32                                `protobuf:"varint,7,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Depth         int32                                `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	ReplyCount    int32                                `protobuf:"varint,9,opt,name=replyCount,proto3" json:"replyCount,omitempty"` // 直接回复数，用 parentId 请求下一层
+	Deleted       bool                                 `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`      // 评论已删除，只为保留回复而留下 "[deleted]" 占位
+	Edited        bool                                 `protobuf:"varint,11,opt,name=edited,proto3" json:"edited,omitempty"`        // 发表后修改过，updatedAt 为最后一次修改的时间
+	Reactions     []*Reaction                          `protobuf:"bytes,12,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Mentions      []*Mention                           `protobuf:"bytes,13,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleArticleReply_Article) Reset() {
-	*x = SingleArticleReply_Article{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+func (x *MultipleCommentReply_Comment) Reset() {
+	*x = MultipleCommentReply_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleArticleReply_Article) String() string {
+func (x *MultipleCommentReply_Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleArticleReply_Article) ProtoMessage() {}
+func (*MultipleCommentReply_Comment) ProtoMessage() {}
 
-func (x *SingleArticleReply_Article) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+func (x *MultipleCommentReply_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,82 +5616,103 @@ func (x *SingleArticleReply_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleArticleReply_Article.ProtoReflect.Descriptor instead.
-func (*SingleArticleReply_Article) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use MultipleCommentReply_Comment.ProtoReflect.Descriptor instead.
+func (*MultipleCommentReply_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{54, 0}
 }
 
-func (x *SingleArticleReply_Article) GetSlug() string {
+func (x *MultipleCommentReply_Comment) GetId() int32 {
 	if x != nil {
-		return x.Slug
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *SingleArticleReply_Article) GetTitle() string {
+func (x *MultipleCommentReply_Comment) GetCreatedAt() string {
 	if x != nil {
-		return x.Title
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article) GetDescription() string {
+func (x *MultipleCommentReply_Comment) GetUpdatedAt() string {
 	if x != nil {
-		return x.Description
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article) GetBody() string {
+func (x *MultipleCommentReply_Comment) GetBody() string {
 	if x != nil {
 		return x.Body
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article) GetTagList() []string {
+func (x *MultipleCommentReply_Comment) GetAuthor() *MultipleCommentReply_Comment_Author {
 	if x != nil {
-		return x.TagList
+		return x.Author
 	}
 	return nil
 }
 
-func (x *SingleArticleReply_Article) GetCreatedAt() string {
+func (x *MultipleCommentReply_Comment) GetBodyHtml() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.BodyHtml
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article) GetUpdatedAt() string {
+func (x *MultipleCommentReply_Comment) GetParentId() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ParentId
 	}
-	return ""
+	return 0
 }
 
-func (x *SingleArticleReply_Article) GetFavorited() bool {
+func (x *MultipleCommentReply_Comment) GetDepth() int32 {
 	if x != nil {
-		return x.Favorited
+		return x.Depth
 	}
-	return false
+	return 0
 }
 
-func (x *SingleArticleReply_Article) GetFavoritesCount() int32 {
+func (x *MultipleCommentReply_Comment) GetReplyCount() int32 {
 	if x != nil {
-		return x.FavoritesCount
+		return x.ReplyCount
 	}
 	return 0
 }
 
-func (x *SingleArticleReply_Article) GetAuthor() *SingleArticleReply_Article_Author {
+func (x *MultipleCommentReply_Comment) GetDeleted() bool {
 	if x != nil {
-		return x.Author
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *MultipleCommentReply_Comment) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *MultipleCommentReply_Comment) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
 	}
 	return nil
 }
 
-type SingleArticleReply_Article_Author struct {
+func (x *MultipleCommentReply_Comment) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type MultipleCommentReply_Comment_Author struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
@@ -1693,21 +5722,21 @@ type SingleArticleReply_Article_Author struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleArticleReply_Article_Author) Reset() {
-	*x = SingleArticleReply_Article_Author{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+func (x *MultipleCommentReply_Comment_Author) Reset() {
+	*x = MultipleCommentReply_Comment_Author{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleArticleReply_Article_Author) String() string {
+func (x *MultipleCommentReply_Comment_Author) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleArticleReply_Article_Author) ProtoMessage() {}
+func (*MultipleCommentReply_Comment_Author) ProtoMessage() {}
 
-func (x *SingleArticleReply_Article_Author) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+func (x *MultipleCommentReply_Comment_Author) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,69 +5747,62 @@ func (x *SingleArticleReply_Article_Author) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleArticleReply_Article_Author.ProtoReflect.Descriptor instead.
-func (*SingleArticleReply_Article_Author) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{17, 0, 0}
+// Deprecated: Use MultipleCommentReply_Comment_Author.ProtoReflect.Descriptor instead.
+func (*MultipleCommentReply_Comment_Author) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{54, 0, 0}
 }
 
-func (x *SingleArticleReply_Article_Author) GetUsername() string {
+func (x *MultipleCommentReply_Comment_Author) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article_Author) GetBio() string {
+func (x *MultipleCommentReply_Comment_Author) GetBio() string {
 	if x != nil {
 		return x.Bio
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article_Author) GetImage() string {
+func (x *MultipleCommentReply_Comment_Author) GetImage() string {
 	if x != nil {
 		return x.Image
 	}
 	return ""
 }
 
-func (x *SingleArticleReply_Article_Author) GetFollowing() bool {
+func (x *MultipleCommentReply_Comment_Author) GetFollowing() bool {
 	if x != nil {
 		return x.Following
 	}
 	return false
 }
 
-type MultipleArticleReply_Article struct {
-	state          protoimpl.MessageState               `protogen:"open.v1"`
-	Slug           string                               `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title          string                               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	TagList        []string                             `protobuf:"bytes,4,rep,name=tagList,proto3" json:"tagList,omitempty"`
-	CreatedAt      string                               `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt      string                               `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Favorited      bool                                 `protobuf:"varint,7,opt,name=favorited,proto3" json:"favorited,omitempty"`
-	FavoritesCount int32                                `protobuf:"varint,8,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
-	Author         *MultipleArticleReply_Article_Author `protobuf:"bytes,9,opt,name=author,proto3" json:"author,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type MultipleCommentEditReply_Edit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`             // 被替换掉的正文
+	ReplacedAt    string                 `protobuf:"bytes,2,opt,name=replacedAt,proto3" json:"replacedAt,omitempty"` // 被新正文替换的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleArticleReply_Article) Reset() {
-	*x = MultipleArticleReply_Article{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+func (x *MultipleCommentEditReply_Edit) Reset() {
+	*x = MultipleCommentEditReply_Edit{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleArticleReply_Article) String() string {
+func (x *MultipleCommentEditReply_Edit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleArticleReply_Article) ProtoMessage() {}
+func (*MultipleCommentEditReply_Edit) ProtoMessage() {}
 
-func (x *MultipleArticleReply_Article) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+func (x *MultipleCommentEditReply_Edit) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,99 +5813,280 @@ func (x *MultipleArticleReply_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleArticleReply_Article.ProtoReflect.Descriptor instead.
-func (*MultipleArticleReply_Article) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use MultipleCommentEditReply_Edit.ProtoReflect.Descriptor instead.
+func (*MultipleCommentEditReply_Edit) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{55, 0}
 }
 
-func (x *MultipleArticleReply_Article) GetSlug() string {
+func (x *MultipleCommentEditReply_Edit) GetBody() string {
 	if x != nil {
-		return x.Slug
+		return x.Body
 	}
 	return ""
 }
 
-func (x *MultipleArticleReply_Article) GetTitle() string {
+func (x *MultipleCommentEditReply_Edit) GetReplacedAt() string {
 	if x != nil {
-		return x.Title
+		return x.ReplacedAt
 	}
 	return ""
 }
 
-func (x *MultipleArticleReply_Article) GetDescription() string {
+type MultipleNotificationReply_Notification struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Id            int32                                           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                                          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                // follow / favorite / comment / mention
+	Actors        []*MultipleNotificationReply_Notification_Actor `protobuf:"bytes,3,rep,name=actors,proto3" json:"actors,omitempty"`            // 最近的几位，最新的在前
+	ActorsCount   int32                                           `protobuf:"varint,4,opt,name=actorsCount,proto3" json:"actorsCount,omitempty"` // 合并进这条通知的总人数
+	ArticleSlug   string                                          `protobuf:"bytes,5,opt,name=articleSlug,proto3" json:"articleSlug,omitempty"`  // follow 通知为空
+	ArticleTitle  string                                          `protobuf:"bytes,6,opt,name=articleTitle,proto3" json:"articleTitle,omitempty"`
+	Message       string                                          `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"` // 例如 alice and 4 others favorited "Title"
+	Read          bool                                            `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     string                                          `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     string                                          `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // 最后一次合并新事件的时间，列表按它倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleNotificationReply_Notification) Reset() {
+	*x = MultipleNotificationReply_Notification{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleNotificationReply_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleNotificationReply_Notification) ProtoMessage() {}
+
+func (x *MultipleNotificationReply_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[93]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleNotificationReply_Notification.ProtoReflect.Descriptor instead.
+func (*MultipleNotificationReply_Notification) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *MultipleNotificationReply_Notification) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MultipleNotificationReply_Notification) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *MultipleArticleReply_Article) GetTagList() []string {
+func (x *MultipleNotificationReply_Notification) GetActors() []*MultipleNotificationReply_Notification_Actor {
 	if x != nil {
-		return x.TagList
+		return x.Actors
 	}
 	return nil
 }
 
-func (x *MultipleArticleReply_Article) GetCreatedAt() string {
+func (x *MultipleNotificationReply_Notification) GetActorsCount() int32 {
+	if x != nil {
+		return x.ActorsCount
+	}
+	return 0
+}
+
+func (x *MultipleNotificationReply_Notification) GetArticleSlug() string {
+	if x != nil {
+		return x.ArticleSlug
+	}
+	return ""
+}
+
+func (x *MultipleNotificationReply_Notification) GetArticleTitle() string {
+	if x != nil {
+		return x.ArticleTitle
+	}
+	return ""
+}
+
+func (x *MultipleNotificationReply_Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MultipleNotificationReply_Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *MultipleNotificationReply_Notification) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *MultipleArticleReply_Article) GetUpdatedAt() string {
+func (x *MultipleNotificationReply_Notification) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *MultipleArticleReply_Article) GetFavorited() bool {
+type MultipleNotificationReply_Notification_Actor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleNotificationReply_Notification_Actor) Reset() {
+	*x = MultipleNotificationReply_Notification_Actor{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleNotificationReply_Notification_Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleNotificationReply_Notification_Actor) ProtoMessage() {}
+
+func (x *MultipleNotificationReply_Notification_Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[94]
 	if x != nil {
-		return x.Favorited
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *MultipleArticleReply_Article) GetFavoritesCount() int32 {
+// Deprecated: Use MultipleNotificationReply_Notification_Actor.ProtoReflect.Descriptor instead.
+func (*MultipleNotificationReply_Notification_Actor) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{56, 0, 0}
+}
+
+func (x *MultipleNotificationReply_Notification_Actor) GetUsername() string {
 	if x != nil {
-		return x.FavoritesCount
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *MultipleArticleReply_Article) GetAuthor() *MultipleArticleReply_Article_Author {
+func (x *MultipleNotificationReply_Notification_Actor) GetImage() string {
 	if x != nil {
-		return x.Author
+		return x.Image
 	}
-	return nil
+	return ""
 }
 
-type MultipleArticleReply_Article_Author struct {
+type MultiplePresenceReply_Presence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,3,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"` // 对方隐藏了在线状态时 online 为 false，lastSeenAt 为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiplePresenceReply_Presence) Reset() {
+	*x = MultiplePresenceReply_Presence{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiplePresenceReply_Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiplePresenceReply_Presence) ProtoMessage() {}
+
+func (x *MultiplePresenceReply_Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiplePresenceReply_Presence.ProtoReflect.Descriptor instead.
+func (*MultiplePresenceReply_Presence) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *MultiplePresenceReply_Presence) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MultiplePresenceReply_Presence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *MultiplePresenceReply_Presence) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+type WebhookReply_Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	All           bool                   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+	Secret        string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"` // 只在创建时返回
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleArticleReply_Article_Author) Reset() {
-	*x = MultipleArticleReply_Article_Author{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+func (x *WebhookReply_Webhook) Reset() {
+	*x = WebhookReply_Webhook{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleArticleReply_Article_Author) String() string {
+func (x *WebhookReply_Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleArticleReply_Article_Author) ProtoMessage() {}
+func (*WebhookReply_Webhook) ProtoMessage() {}
 
-func (x *MultipleArticleReply_Article_Author) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+func (x *WebhookReply_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,65 +6097,83 @@ func (x *MultipleArticleReply_Article_Author) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleArticleReply_Article_Author.ProtoReflect.Descriptor instead.
-func (*MultipleArticleReply_Article_Author) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{18, 0, 0}
+// Deprecated: Use WebhookReply_Webhook.ProtoReflect.Descriptor instead.
+func (*WebhookReply_Webhook) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{61, 0}
 }
 
-func (x *MultipleArticleReply_Article_Author) GetUsername() string {
+func (x *WebhookReply_Webhook) GetId() int32 {
 	if x != nil {
-		return x.Username
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *MultipleArticleReply_Article_Author) GetBio() string {
+func (x *WebhookReply_Webhook) GetUrl() string {
 	if x != nil {
-		return x.Bio
+		return x.Url
 	}
 	return ""
 }
 
-func (x *MultipleArticleReply_Article_Author) GetImage() string {
+func (x *WebhookReply_Webhook) GetEvents() []string {
 	if x != nil {
-		return x.Image
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (x *MultipleArticleReply_Article_Author) GetFollowing() bool {
+func (x *WebhookReply_Webhook) GetAll() bool {
 	if x != nil {
-		return x.Following
+		return x.All
 	}
 	return false
 }
 
-type SingleCommentReply_Comment struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Id            int32                              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                             `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     string                             `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Body          string                             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	Author        *SingleCommentReply_Comment_Author `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WebhookReply_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
 }
 
-func (x *SingleCommentReply_Comment) Reset() {
-	*x = SingleCommentReply_Comment{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+func (x *WebhookReply_Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type MultipleWebhookDeliveryReply_Delivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 即 X-Webhook-Delivery 请求头
+	Event          string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending / delivered / dead
+	Attempts       int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,5,opt,name=responseStatus,proto3" json:"responseStatus,omitempty"` // 最后一次尝试的 HTTP 状态码，没有收到响应时为 0
+	Error          string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                    // 最后一次失败的原因
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	NextAttemptAt  string                 `protobuf:"bytes,8,opt,name=nextAttemptAt,proto3" json:"nextAttemptAt,omitempty"` // pending 时下一次尝试的时间
+	DeliveredAt    string                 `protobuf:"bytes,9,opt,name=deliveredAt,proto3" json:"deliveredAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MultipleWebhookDeliveryReply_Delivery) Reset() {
+	*x = MultipleWebhookDeliveryReply_Delivery{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleCommentReply_Comment) String() string {
+func (x *MultipleWebhookDeliveryReply_Delivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleCommentReply_Comment) ProtoMessage() {}
+func (*MultipleWebhookDeliveryReply_Delivery) ProtoMessage() {}
 
-func (x *SingleCommentReply_Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+func (x *MultipleWebhookDeliveryReply_Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,71 +6184,102 @@ func (x *SingleCommentReply_Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleCommentReply_Comment.ProtoReflect.Descriptor instead.
-func (*SingleCommentReply_Comment) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use MultipleWebhookDeliveryReply_Delivery.ProtoReflect.Descriptor instead.
+func (*MultipleWebhookDeliveryReply_Delivery) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{63, 0}
 }
 
-func (x *SingleCommentReply_Comment) GetId() int32 {
+func (x *MultipleWebhookDeliveryReply_Delivery) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *SingleCommentReply_Comment) GetCreatedAt() string {
+func (x *MultipleWebhookDeliveryReply_Delivery) GetEvent() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Event
 	}
 	return ""
 }
 
-func (x *SingleCommentReply_Comment) GetUpdatedAt() string {
+func (x *MultipleWebhookDeliveryReply_Delivery) GetStatus() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SingleCommentReply_Comment) GetBody() string {
+func (x *MultipleWebhookDeliveryReply_Delivery) GetAttempts() int32 {
 	if x != nil {
-		return x.Body
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *MultipleWebhookDeliveryReply_Delivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *MultipleWebhookDeliveryReply_Delivery) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *SingleCommentReply_Comment) GetAuthor() *SingleCommentReply_Comment_Author {
+func (x *MultipleWebhookDeliveryReply_Delivery) GetCreatedAt() string {
 	if x != nil {
-		return x.Author
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-type SingleCommentReply_Comment_Author struct {
+func (x *MultipleWebhookDeliveryReply_Delivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *MultipleWebhookDeliveryReply_Delivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+// 关注的文章下的新评论
+type LiveEvent_Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleSlug   string                 `protobuf:"bytes,2,opt,name=articleSlug,proto3" json:"articleSlug,omitempty"`
+	ParentId      int32                  `protobuf:"varint,3,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleCommentReply_Comment_Author) Reset() {
-	*x = SingleCommentReply_Comment_Author{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+func (x *LiveEvent_Comment) Reset() {
+	*x = LiveEvent_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleCommentReply_Comment_Author) String() string {
+func (x *LiveEvent_Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleCommentReply_Comment_Author) ProtoMessage() {}
+func (*LiveEvent_Comment) ProtoMessage() {}
 
-func (x *SingleCommentReply_Comment_Author) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+func (x *LiveEvent_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,65 +6290,77 @@ func (x *SingleCommentReply_Comment_Author) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleCommentReply_Comment_Author.ProtoReflect.Descriptor instead.
-func (*SingleCommentReply_Comment_Author) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{19, 0, 0}
+// Deprecated: Use LiveEvent_Comment.ProtoReflect.Descriptor instead.
+func (*LiveEvent_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64, 0}
 }
 
-func (x *SingleCommentReply_Comment_Author) GetUsername() string {
+func (x *LiveEvent_Comment) GetId() int32 {
 	if x != nil {
-		return x.Username
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveEvent_Comment) GetArticleSlug() string {
+	if x != nil {
+		return x.ArticleSlug
 	}
 	return ""
 }
 
-func (x *SingleCommentReply_Comment_Author) GetBio() string {
+func (x *LiveEvent_Comment) GetParentId() int32 {
 	if x != nil {
-		return x.Bio
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *LiveEvent_Comment) GetAuthor() string {
+	if x != nil {
+		return x.Author
 	}
 	return ""
 }
 
-func (x *SingleCommentReply_Comment_Author) GetImage() string {
+func (x *LiveEvent_Comment) GetBody() string {
 	if x != nil {
-		return x.Image
+		return x.Body
 	}
 	return ""
 }
 
-func (x *SingleCommentReply_Comment_Author) GetFollowing() bool {
+func (x *LiveEvent_Comment) GetCreatedAt() string {
 	if x != nil {
-		return x.Following
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-type MultipleCommentReply_Comment struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Id            int32                                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                               `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     string                               `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Body          string                               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	Author        *MultipleCommentReply_Comment_Author `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+// 通知有新增或合并，客户端重新拉取通知列表
+type LiveEvent_Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleCommentReply_Comment) Reset() {
-	*x = MultipleCommentReply_Comment{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+func (x *LiveEvent_Notification) Reset() {
+	*x = LiveEvent_Notification{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleCommentReply_Comment) String() string {
+func (x *LiveEvent_Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleCommentReply_Comment) ProtoMessage() {}
+func (*LiveEvent_Notification) ProtoMessage() {}
 
-func (x *MultipleCommentReply_Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+func (x *LiveEvent_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,71 +6371,111 @@ func (x *MultipleCommentReply_Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleCommentReply_Comment.ProtoReflect.Descriptor instead.
-func (*MultipleCommentReply_Comment) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use LiveEvent_Notification.ProtoReflect.Descriptor instead.
+func (*LiveEvent_Notification) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64, 1}
 }
 
-func (x *MultipleCommentReply_Comment) GetId() int32 {
+func (x *LiveEvent_Notification) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MultipleCommentReply_Comment) GetCreatedAt() string {
+func (x *LiveEvent_Notification) GetKind() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *MultipleCommentReply_Comment) GetUpdatedAt() string {
+// 关注的作者发布了新文章
+type LiveEvent_Feed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveEvent_Feed) Reset() {
+	*x = LiveEvent_Feed{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveEvent_Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveEvent_Feed) ProtoMessage() {}
+
+func (x *LiveEvent_Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[100]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveEvent_Feed.ProtoReflect.Descriptor instead.
+func (*LiveEvent_Feed) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64, 2}
+}
+
+func (x *LiveEvent_Feed) GetSlug() string {
+	if x != nil {
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *MultipleCommentReply_Comment) GetBody() string {
+func (x *LiveEvent_Feed) GetTitle() string {
 	if x != nil {
-		return x.Body
+		return x.Title
 	}
 	return ""
 }
 
-func (x *MultipleCommentReply_Comment) GetAuthor() *MultipleCommentReply_Comment_Author {
+func (x *LiveEvent_Feed) GetAuthor() string {
 	if x != nil {
 		return x.Author
 	}
-	return nil
+	return ""
 }
 
-type MultipleCommentReply_Comment_Author struct {
+// 关注的用户上线或下线了
+type LiveEvent_Presence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,3,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleCommentReply_Comment_Author) Reset() {
-	*x = MultipleCommentReply_Comment_Author{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+func (x *LiveEvent_Presence) Reset() {
+	*x = LiveEvent_Presence{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleCommentReply_Comment_Author) String() string {
+func (x *LiveEvent_Presence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleCommentReply_Comment_Author) ProtoMessage() {}
+func (*LiveEvent_Presence) ProtoMessage() {}
 
-func (x *MultipleCommentReply_Comment_Author) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+func (x *LiveEvent_Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2182,44 +6486,37 @@ func (x *MultipleCommentReply_Comment_Author) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleCommentReply_Comment_Author.ProtoReflect.Descriptor instead.
-func (*MultipleCommentReply_Comment_Author) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20, 0, 0}
+// Deprecated: Use LiveEvent_Presence.ProtoReflect.Descriptor instead.
+func (*LiveEvent_Presence) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64, 3}
 }
 
-func (x *MultipleCommentReply_Comment_Author) GetUsername() string {
+func (x *LiveEvent_Presence) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *MultipleCommentReply_Comment_Author) GetBio() string {
+func (x *LiveEvent_Presence) GetOnline() bool {
 	if x != nil {
-		return x.Bio
+		return x.Online
 	}
-	return ""
+	return false
 }
 
-func (x *MultipleCommentReply_Comment_Author) GetImage() string {
+func (x *LiveEvent_Presence) GetLastSeenAt() string {
 	if x != nil {
-		return x.Image
+		return x.LastSeenAt
 	}
 	return ""
 }
 
-func (x *MultipleCommentReply_Comment_Author) GetFollowing() bool {
-	if x != nil {
-		return x.Following
-	}
-	return false
-}
-
 var File_realworld_v1_realworld_proto protoreflect.FileDescriptor
 
 const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\n" +
-	"\x1crealworld/v1/realworld.proto\x12\frealworld.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"{\n" +
+	"\x1crealworld/v1/realworld.proto\x12\frealworld.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"{\n" +
 	"\vAuthRequest\x122\n" +
 	"\x04user\x18\x01 \x01(\v2\x1e.realworld.v1.AuthRequest.UserR\x04user\x1a8\n" +
 	"\x04User\x12\x14\n" +
@@ -2230,75 +6527,215 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\x04User\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"\xcb\x01\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"\xa3\x02\n" +
 	"\x11UpdateUserRequest\x128\n" +
-	"\x04user\x18\x01 \x01(\v2$.realworld.v1.UpdateUserRequest.UserR\x04user\x1a|\n" +
+	"\x04user\x18\x01 \x01(\v2$.realworld.v1.UpdateUserRequest.UserR\x04user\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x1a\x96\x01\n" +
 	"\x04User\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x10\n" +
 	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x14\n" +
-	"\x05image\x18\x05 \x01(\tR\x05image\"/\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\"/\n" +
 	"\x11GetProfileRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"/\n" +
 	"\x11FollowUserRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"\x8b\x01\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"w\n" +
+	"\x15SearchProfilesRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\"\n" +
+	"\fautocomplete\x18\x02 \x01(\bR\fautocomplete\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"x\n" +
+	"\x14ListFollowersRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\".\n" +
+	"\x16ListSuggestionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\xa3\x01\n" +
 	"\x13ListArticlesRequest\x12\x10\n" +
 	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x16\n" +
 	"\x06author\x18\x02 \x01(\tR\x06author\x12\x1c\n" +
 	"\tfavorited\x18\x03 \x01(\tR\tfavorited\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"C\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"[\n" +
 	"\x13FeedArticlesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"'\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"B\n" +
+	"\x16RelatedArticlesRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"G\n" +
+	"\x17TrendingArticlesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"Y\n" +
+	"\x11ListDraftsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"}\n" +
+	"\x15SearchArticlesRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\";\n" +
 	"\x11GetArticleRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"*\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\bR\x04html\"*\n" +
 	"\x14DeleteArticleRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"\xcd\x01\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"\xe6\x01\n" +
 	"\x14CreateArticleRequest\x12D\n" +
-	"\aarticle\x18\x01 \x01(\v2*.realworld.v1.CreateArticleRequest.ArticleR\aarticle\x1ao\n" +
+	"\aarticle\x18\x01 \x01(\v2*.realworld.v1.CreateArticleRequest.ArticleR\aarticle\x1a\x87\x01\n" +
 	"\aArticle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x18\n" +
-	"\atagList\x18\x04 \x03(\tR\atagList\"\xc7\x01\n" +
+	"\atagList\x18\x04 \x03(\tR\atagList\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\x9e\x02\n" +
 	"\x14UpdateArticleRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12D\n" +
-	"\aarticle\x18\x02 \x01(\v2*.realworld.v1.UpdateArticleRequest.ArticleR\aarticle\x1aU\n" +
+	"\aarticle\x18\x02 \x01(\v2*.realworld.v1.UpdateArticleRequest.ArticleR\aarticle\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x1ao\n" +
 	"\aArticle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04body\"\x8b\x01\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"\xbb\x01\n" +
 	"\x12AddCommentsRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12B\n" +
-	"\acomment\x18\x02 \x01(\v2(.realworld.v1.AddCommentsRequest.CommentR\acomment\x1a\x1d\n" +
+	"\acomment\x18\x02 \x01(\v2(.realworld.v1.AddCommentsRequest.CommentR\acomment\x12\x12\n" +
+	"\x04html\x18\x03 \x01(\bR\x04html\x1a9\n" +
 	"\aComment\x12\x12\n" +
-	"\x04body\x18\x01 \x01(\tR\x04body\"(\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x12\x1a\n" +
+	"\bparentId\x18\x02 \x01(\x05R\bparentId\"\x9e\x01\n" +
 	"\x12GetCommentsRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\":\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04html\x18\x05 \x01(\bR\x04html\x12\x1a\n" +
+	"\bparentId\x18\x06 \x01(\x05R\bparentId\"\xb3\x01\n" +
+	"\x14UpdateCommentRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12D\n" +
+	"\acomment\x18\x03 \x01(\v2*.realworld.v1.UpdateCommentRequest.CommentR\acomment\x12\x12\n" +
+	"\x04html\x18\x04 \x01(\bR\x04html\x1a\x1d\n" +
+	"\aComment\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"=\n" +
+	"\x17ListCommentEditsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\":\n" +
 	"\x14DeleteCommentRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x05R\x02id\",\n" +
 	"\x16FavoriteArticleRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"\xb5\x01\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"H\n" +
+	"\x16ArticleReactionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\"X\n" +
+	"\x16CommentReactionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\"G\n" +
+	"\x15PublishArticleRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\bunlisted\x18\x02 \x01(\bR\bunlisted\"N\n" +
+	"\x16ScheduleArticleRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12 \n" +
+	"\vscheduledAt\x18\x02 \x01(\tR\vscheduledAt\"*\n" +
+	"\x14ArticleStatusRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"x\n" +
+	"\x18ListNotificationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x16\n" +
+	"\x06unread\x18\x04 \x01(\bR\x06unread\"-\n" +
+	"\x1bMarkNotificationReadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"2\n" +
+	"\x12GetPresenceRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"7\n" +
+	"\x1dUpdatePresenceSettingsRequest\x12\x16\n" +
+	"\x06hidden\x18\x01 \x01(\bR\x06hidden\"\xbb\x01\n" +
+	"\x14CreateWebhookRequest\x12D\n" +
+	"\awebhook\x18\x01 \x01(\v2*.realworld.v1.CreateWebhookRequest.WebhookR\awebhook\x1a]\n" +
+	"\aWebhook\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x10\n" +
+	"\x03all\x18\x04 \x01(\bR\x03all\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8c\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"N\n" +
+	"\x10SubscribeRequest\x12\x18\n" +
+	"\aarticle\x18\x01 \x01(\tR\aarticle\x12 \n" +
+	"\vlastEventId\x18\x02 \x01(\tR\vlastEventId\"p\n" +
+	"\x14ListRevisionsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"D\n" +
+	"\x12GetRevisionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"b\n" +
+	"\x16RestoreRevisionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"N\n" +
+	"\x14DiffRevisionsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x05R\x02to\"\xf4\x01\n" +
 	"\tUserReply\x120\n" +
-	"\x04user\x18\x01 \x01(\v2\x1c.realworld.v1.UserReply.UserR\x04user\x1av\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.realworld.v1.UserReply.UserR\x04user\x1a\xb4\x01\n" +
 	"\x04User\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x14\n" +
-	"\x05image\x18\x05 \x01(\tR\x05image\"\xb9\x01\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\"\n" +
+	"\fhidePresence\x18\a \x01(\bR\fhidePresence\"\xf2\x01\n" +
 	"\fProfileReply\x12<\n" +
-	"\aprofile\x18\x01 \x01(\v2\".realworld.v1.ProfileReply.ProfileR\aprofile\x1ak\n" +
+	"\aprofile\x18\x01 \x01(\v2\".realworld.v1.ProfileReply.ProfileR\aprofile\x1a\xa3\x01\n" +
+	"\aProfile\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\x12\x16\n" +
+	"\x06online\x18\x05 \x01(\bR\x06online\x12\x1e\n" +
+	"\n" +
+	"lastSeenAt\x18\x06 \x01(\tR\n" +
+	"lastSeenAt\"\xec\x01\n" +
+	"\x14MultipleProfileReply\x12F\n" +
+	"\bprofiles\x18\x01 \x03(\v2*.realworld.v1.MultipleProfileReply.ProfileR\bprofiles\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x1ak\n" +
 	"\aProfile\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\x95\x04\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"V\n" +
+	"\bReaction\x12\x1a\n" +
+	"\breaction\x18\x01 \x01(\tR\breaction\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\areacted\x18\x03 \x01(\bR\areacted\"F\n" +
+	"\x0eReactionsReply\x124\n" +
+	"\treactions\x18\x01 \x03(\v2\x16.realworld.v1.ReactionR\treactions\"M\n" +
+	"\aMention\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\"\xf9\a\n" +
 	"\x12SingleArticleReply\x12B\n" +
-	"\aarticle\x18\x01 \x01(\v2(.realworld.v1.SingleArticleReply.ArticleR\aarticle\x1a\xba\x03\n" +
+	"\aarticle\x18\x01 \x01(\v2(.realworld.v1.SingleArticleReply.ArticleR\aarticle\x1a\x9e\a\n" +
 	"\aArticle\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2310,15 +6747,34 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\tfavorited\x18\b \x01(\bR\tfavorited\x12&\n" +
 	"\x0efavoritesCount\x18\t \x01(\x05R\x0efavoritesCount\x12G\n" +
 	"\x06author\x18\n" +
-	" \x01(\v2/.realworld.v1.SingleArticleReply.Article.AuthorR\x06author\x1aj\n" +
+	" \x01(\v2/.realworld.v1.SingleArticleReply.Article.AuthorR\x06author\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12 \n" +
+	"\vpublishedAt\x18\f \x01(\tR\vpublishedAt\x12 \n" +
+	"\vscheduledAt\x18\r \x01(\tR\vscheduledAt\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x03R\aversion\x12\x1a\n" +
+	"\bbodyHtml\x18\x0f \x01(\tR\bbodyHtml\x12\x1c\n" +
+	"\twordCount\x18\x10 \x01(\x05R\twordCount\x12 \n" +
+	"\vreadingTime\x18\x11 \x01(\x05R\vreadingTime\x12B\n" +
+	"\x03toc\x18\x12 \x03(\v20.realworld.v1.SingleArticleReply.Article.HeadingR\x03toc\x12\x1e\n" +
+	"\n" +
+	"viewsCount\x18\x13 \x01(\x03R\n" +
+	"viewsCount\x124\n" +
+	"\treactions\x18\x14 \x03(\v2\x16.realworld.v1.ReactionR\treactions\x121\n" +
+	"\bmentions\x18\x15 \x03(\v2\x15.realworld.v1.MentionR\bmentions\x1aj\n" +
 	"\x06Author\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\xaf\x04\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\x1aC\n" +
+	"\aHeading\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"\xa6\x05\n" +
 	"\x14MultipleArticleReply\x12F\n" +
 	"\barticles\x18\x01 \x03(\v2*.realworld.v1.MultipleArticleReply.ArticleR\barticles\x12$\n" +
-	"\rarticlesCount\x18\x02 \x01(\x05R\rarticlesCount\x1a\xa8\x03\n" +
+	"\rarticlesCount\x18\x02 \x01(\x05R\rarticlesCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x1a\xfe\x03\n" +
 	"\aArticle\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2328,65 +6784,276 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\tupdatedAt\x18\x06 \x01(\tR\tupdatedAt\x12\x1c\n" +
 	"\tfavorited\x18\a \x01(\bR\tfavorited\x12&\n" +
 	"\x0efavoritesCount\x18\b \x01(\x05R\x0efavoritesCount\x12I\n" +
-	"\x06author\x18\t \x01(\v21.realworld.v1.MultipleArticleReply.Article.AuthorR\x06author\x1aj\n" +
+	"\x06author\x18\t \x01(\v21.realworld.v1.MultipleArticleReply.Article.AuthorR\x06author\x12\x1e\n" +
+	"\n" +
+	"viewsCount\x18\n" +
+	" \x01(\x03R\n" +
+	"viewsCount\x124\n" +
+	"\treactions\x18\v \x03(\v2\x16.realworld.v1.ReactionR\treactions\x1aj\n" +
+	"\x06Author\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\x82\x05\n" +
+	"\x13SearchArticlesReply\x12E\n" +
+	"\barticles\x18\x01 \x03(\v2).realworld.v1.SearchArticlesReply.ArticleR\barticles\x12$\n" +
+	"\rarticlesCount\x18\x02 \x01(\x05R\rarticlesCount\x1a\xfd\x03\n" +
+	"\aArticle\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\atagList\x18\x04 \x03(\tR\atagList\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x06 \x01(\tR\tupdatedAt\x12\x1c\n" +
+	"\tfavorited\x18\a \x01(\bR\tfavorited\x12&\n" +
+	"\x0efavoritesCount\x18\b \x01(\x05R\x0efavoritesCount\x12H\n" +
+	"\x06author\x18\t \x01(\v20.realworld.v1.SearchArticlesReply.Article.AuthorR\x06author\x12&\n" +
+	"\x0etitleHighlight\x18\n" +
+	" \x01(\tR\x0etitleHighlight\x12\x18\n" +
+	"\asnippet\x18\v \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\f \x01(\x02R\x04rank\x1aj\n" +
 	"\x06Author\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\xf9\x02\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\xad\x03\n" +
+	"\x13SingleRevisionReply\x12F\n" +
+	"\brevision\x18\x01 \x01(\v2*.realworld.v1.SingleRevisionReply.RevisionR\brevision\x1a\xcd\x02\n" +
+	"\bRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12I\n" +
+	"\x06editor\x18\x06 \x01(\v21.realworld.v1.SingleRevisionReply.Revision.EditorR\x06editor\x12\"\n" +
+	"\frestoredFrom\x18\a \x01(\x05R\frestoredFrom\x1aL\n" +
+	"\x06Editor\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\"\xc2\x03\n" +
+	"\x15MultipleRevisionReply\x12J\n" +
+	"\trevisions\x18\x01 \x03(\v2,.realworld.v1.MultipleRevisionReply.RevisionR\trevisions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x1a\xbb\x02\n" +
+	"\bRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\tR\tcreatedAt\x12K\n" +
+	"\x06editor\x18\x05 \x01(\v23.realworld.v1.MultipleRevisionReply.Revision.EditorR\x06editor\x12\"\n" +
+	"\frestoredFrom\x18\x06 \x01(\x05R\frestoredFrom\x1aL\n" +
+	"\x06Editor\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\"K\n" +
+	"\x11RevisionDiffReply\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x05R\x02to\x12\x12\n" +
+	"\x04diff\x18\x03 \x01(\tR\x04diff\"\xdc\x04\n" +
 	"\x12SingleCommentReply\x12B\n" +
-	"\acomment\x18\x01 \x01(\v2(.realworld.v1.SingleCommentReply.CommentR\acomment\x1a\x9e\x02\n" +
+	"\acomment\x18\x01 \x01(\v2(.realworld.v1.SingleCommentReply.CommentR\acomment\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x1a\xed\x03\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
 	"\tcreatedAt\x18\x02 \x01(\tR\tcreatedAt\x12\x1c\n" +
 	"\tupdatedAt\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12G\n" +
-	"\x06author\x18\x05 \x01(\v2/.realworld.v1.SingleCommentReply.Comment.AuthorR\x06author\x1aj\n" +
+	"\x06author\x18\x05 \x01(\v2/.realworld.v1.SingleCommentReply.Comment.AuthorR\x06author\x12\x1a\n" +
+	"\bbodyHtml\x18\x06 \x01(\tR\bbodyHtml\x12\x1a\n" +
+	"\bparentId\x18\a \x01(\x05R\bparentId\x12\x14\n" +
+	"\x05depth\x18\b \x01(\x05R\x05depth\x12\x16\n" +
+	"\x06edited\x18\t \x01(\bR\x06edited\x124\n" +
+	"\treactions\x18\n" +
+	" \x03(\v2\x16.realworld.v1.ReactionR\treactions\x121\n" +
+	"\bmentions\x18\v \x03(\v2\x15.realworld.v1.MentionR\bmentions\x1aj\n" +
 	"\x06Author\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\x81\x03\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\xbf\x05\n" +
 	"\x14MultipleCommentReply\x12F\n" +
-	"\bcomments\x18\x01 \x03(\v2*.realworld.v1.MultipleCommentReply.CommentR\bcomments\x1a\xa0\x02\n" +
+	"\bcomments\x18\x01 \x03(\v2*.realworld.v1.MultipleCommentReply.CommentR\bcomments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x1a\xa9\x04\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
 	"\tcreatedAt\x18\x02 \x01(\tR\tcreatedAt\x12\x1c\n" +
 	"\tupdatedAt\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12I\n" +
-	"\x06author\x18\x05 \x01(\v21.realworld.v1.MultipleCommentReply.Comment.AuthorR\x06author\x1aj\n" +
+	"\x06author\x18\x05 \x01(\v21.realworld.v1.MultipleCommentReply.Comment.AuthorR\x06author\x12\x1a\n" +
+	"\bbodyHtml\x18\x06 \x01(\tR\bbodyHtml\x12\x1a\n" +
+	"\bparentId\x18\a \x01(\x05R\bparentId\x12\x14\n" +
+	"\x05depth\x18\b \x01(\x05R\x05depth\x12\x1e\n" +
+	"\n" +
+	"replyCount\x18\t \x01(\x05R\n" +
+	"replyCount\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeleted\x12\x16\n" +
+	"\x06edited\x18\v \x01(\bR\x06edited\x124\n" +
+	"\treactions\x18\f \x03(\v2\x16.realworld.v1.ReactionR\treactions\x121\n" +
+	"\bmentions\x18\r \x03(\v2\x15.realworld.v1.MentionR\bmentions\x1aj\n" +
 	"\x06Author\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"#\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\x99\x01\n" +
+	"\x18MultipleCommentEditReply\x12A\n" +
+	"\x05edits\x18\x01 \x03(\v2+.realworld.v1.MultipleCommentEditReply.EditR\x05edits\x1a:\n" +
+	"\x04Edit\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x12\x1e\n" +
+	"\n" +
+	"replacedAt\x18\x02 \x01(\tR\n" +
+	"replacedAt\"\xae\x04\n" +
+	"\x19MultipleNotificationReply\x12Z\n" +
+	"\rnotifications\x18\x01 \x03(\v24.realworld.v1.MultipleNotificationReply.NotificationR\rnotifications\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x1a\x93\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12R\n" +
+	"\x06actors\x18\x03 \x03(\v2:.realworld.v1.MultipleNotificationReply.Notification.ActorR\x06actors\x12 \n" +
+	"\vactorsCount\x18\x04 \x01(\x05R\vactorsCount\x12 \n" +
+	"\varticleSlug\x18\x05 \x01(\tR\varticleSlug\x12\"\n" +
+	"\farticleTitle\x18\x06 \x01(\tR\farticleTitle\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x12\n" +
+	"\x04read\x18\b \x01(\bR\x04read\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\tR\tupdatedAt\x1a9\n" +
+	"\x05Actor\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\"(\n" +
+	"\x10UnreadCountReply\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"G\n" +
+	"\x0fLiveTicketReply\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x1c\n" +
+	"\texpiresIn\x18\x02 \x01(\x05R\texpiresIn\"\xc3\x01\n" +
+	"\x15MultiplePresenceReply\x12J\n" +
+	"\tpresences\x18\x01 \x03(\v2,.realworld.v1.MultiplePresenceReply.PresenceR\tpresences\x1a^\n" +
+	"\bPresence\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\bR\x06online\x12\x1e\n" +
+	"\n" +
+	"lastSeenAt\x18\x03 \x01(\tR\n" +
+	"lastSeenAt\"/\n" +
+	"\x15PresenceSettingsReply\x12\x16\n" +
+	"\x06hidden\x18\x01 \x01(\bR\x06hidden\"\xda\x01\n" +
+	"\fWebhookReply\x12<\n" +
+	"\awebhook\x18\x01 \x01(\v2\".realworld.v1.WebhookReply.WebhookR\awebhook\x1a\x8b\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x10\n" +
+	"\x03all\x18\x04 \x01(\bR\x03all\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"V\n" +
+	"\x14MultipleWebhookReply\x12>\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\".realworld.v1.WebhookReply.WebhookR\bwebhooks\"\x9f\x03\n" +
+	"\x1cMultipleWebhookDeliveryReply\x12S\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v23.realworld.v1.MultipleWebhookDeliveryReply.DeliveryR\n" +
+	"deliveries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x1a\x88\x02\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12&\n" +
+	"\x0eresponseStatus\x18\x05 \x01(\x05R\x0eresponseStatus\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\x12$\n" +
+	"\rnextAttemptAt\x18\b \x01(\tR\rnextAttemptAt\x12 \n" +
+	"\vdeliveredAt\x18\t \x01(\tR\vdeliveredAt\"\xa3\x05\n" +
+	"\tLiveEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\acomment\x18\x02 \x01(\v2\x1f.realworld.v1.LiveEvent.CommentH\x00R\acomment\x12J\n" +
+	"\fnotification\x18\x03 \x01(\v2$.realworld.v1.LiveEvent.NotificationH\x00R\fnotification\x122\n" +
+	"\x04feed\x18\x04 \x01(\v2\x1c.realworld.v1.LiveEvent.FeedH\x00R\x04feed\x12>\n" +
+	"\bpresence\x18\x05 \x01(\v2 .realworld.v1.LiveEvent.PresenceH\x00R\bpresence\x1a\xa1\x01\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12 \n" +
+	"\varticleSlug\x18\x02 \x01(\tR\varticleSlug\x12\x1a\n" +
+	"\bparentId\x18\x03 \x01(\x05R\bparentId\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x1a2\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x1aH\n" +
+	"\x04Feed\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x1a^\n" +
+	"\bPresence\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\bR\x06online\x12\x1e\n" +
+	"\n" +
+	"lastSeenAt\x18\x03 \x01(\tR\n" +
+	"lastSeenAtB\a\n" +
+	"\x05event\"#\n" +
 	"\rListTagsReply\x12\x12\n" +
-	"\x04tags\x18\x01 \x03(\tR\x04tags2\xbc\x10\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags2\xc41\n" +
 	"\tRealWorld\x12X\n" +
 	"\x05Login\x12\x19.realworld.v1.AuthRequest\x1a\x17.realworld.v1.UserReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/users/login\x12Y\n" +
 	"\bRegister\x12\x1d.realworld.v1.RegisterRequest\x1a\x17.realworld.v1.UserReply\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/api/users\x12T\n" +
 	"\x0eGetCurrentUser\x12\x16.google.protobuf.Empty\x1a\x17.realworld.v1.UserReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/user\x12\\\n" +
 	"\n" +
-	"UpdateUser\x12\x1f.realworld.v1.UpdateUserRequest\x1a\x17.realworld.v1.UserReply\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\x1a\t/api/user\x12k\n" +
+	"UpdateUser\x12\x1f.realworld.v1.UpdateUserRequest\x1a\x17.realworld.v1.UserReply\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\x1a\t/api/user\x12\x83\x01\n" +
+	"\rListFollowers\x12\".realworld.v1.ListFollowersRequest\x1a\".realworld.v1.MultipleProfileReply\"*\x82\xd3\xe4\x93\x02$\x12\"/api/profiles/{username}/followers\x12w\n" +
+	"\x0eSearchProfiles\x12#.realworld.v1.SearchProfilesRequest\x1a\".realworld.v1.MultipleProfileReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/profiles/search\x12~\n" +
+	"\x0fListSuggestions\x12$.realworld.v1.ListSuggestionsRequest\x1a\".realworld.v1.MultipleProfileReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/profiles/suggestions\x12k\n" +
 	"\n" +
 	"GetProfile\x12\x1f.realworld.v1.GetProfileRequest\x1a\x1a.realworld.v1.ProfileReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/profiles/{username}\x12r\n" +
 	"\n" +
 	"FollowUser\x12\x1f.realworld.v1.FollowUserRequest\x1a\x1a.realworld.v1.ProfileReply\"'\x82\xd3\xe4\x93\x02!\"\x1f/api/profiles/{username}/follow\x12t\n" +
 	"\fUnFollowUser\x12\x1f.realworld.v1.FollowUserRequest\x1a\x1a.realworld.v1.ProfileReply\"'\x82\xd3\xe4\x93\x02!*\x1f/api/profiles/{username}/follow\x12l\n" +
-	"\fListArticles\x12!.realworld.v1.ListArticlesRequest\x1a\".realworld.v1.MultipleArticleReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/articles\x12q\n" +
-	"\fFeedArticles\x12!.realworld.v1.FeedArticlesRequest\x1a\".realworld.v1.MultipleArticleReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/articles/feed\x12m\n" +
+	"\fListArticles\x12!.realworld.v1.ListArticlesRequest\x1a\".realworld.v1.MultipleArticleReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/articles\x12}\n" +
+	"\x10TrendingArticles\x12%.realworld.v1.TrendingArticlesRequest\x1a\".realworld.v1.MultipleArticleReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/articles/trending\x12q\n" +
+	"\fFeedArticles\x12!.realworld.v1.FeedArticlesRequest\x1a\".realworld.v1.MultipleArticleReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/articles/feed\x12o\n" +
 	"\n" +
-	"GetArticle\x12\x1f.realworld.v1.GetArticleRequest\x1a .realworld.v1.SingleArticleReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/articles/{slug}\x12o\n" +
+	"ListDrafts\x12\x1f.realworld.v1.ListDraftsRequest\x1a\".realworld.v1.MultipleArticleReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/articles/drafts\x12v\n" +
+	"\x0eSearchArticles\x12#.realworld.v1.SearchArticlesRequest\x1a!.realworld.v1.SearchArticlesReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/articles/search\x12m\n" +
+	"\n" +
+	"GetArticle\x12\x1f.realworld.v1.GetArticleRequest\x1a .realworld.v1.SingleArticleReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/articles/{slug}\x12\x81\x01\n" +
+	"\x0fRelatedArticles\x12$.realworld.v1.RelatedArticlesRequest\x1a\".realworld.v1.MultipleArticleReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/articles/{slug}/related\x12o\n" +
 	"\rCreateArticle\x12\".realworld.v1.CreateArticleRequest\x1a .realworld.v1.SingleArticleReply\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/articles\x12v\n" +
-	"\rUpdateArticle\x12\".realworld.v1.UpdateArticleRequest\x1a .realworld.v1.SingleArticleReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/articles/{slug}\x12i\n" +
+	"\rUpdateArticle\x12\".realworld.v1.UpdateArticleRequest\x1a .realworld.v1.SingleArticleReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/articles/{slug}\x12\x80\x01\n" +
+	"\x0ePublishArticle\x12#.realworld.v1.PublishArticleRequest\x1a .realworld.v1.SingleArticleReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/articles/{slug}/publish\x12\x83\x01\n" +
+	"\x0fScheduleArticle\x12$.realworld.v1.ScheduleArticleRequest\x1a .realworld.v1.SingleArticleReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/articles/{slug}/schedule\x12\x80\x01\n" +
+	"\x10UnpublishArticle\x12\".realworld.v1.ArticleStatusRequest\x1a .realworld.v1.SingleArticleReply\"&\x82\xd3\xe4\x93\x02 \"\x1e/api/articles/{slug}/unpublish\x12|\n" +
+	"\x0eArchiveArticle\x12\".realworld.v1.ArticleStatusRequest\x1a .realworld.v1.SingleArticleReply\"$\x82\xd3\xe4\x93\x02\x1e\"\x1c/api/articles/{slug}/archive\x12\x80\x01\n" +
+	"\rListRevisions\x12\".realworld.v1.ListRevisionsRequest\x1a#.realworld.v1.MultipleRevisionReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/articles/{slug}/revisions\x12\x85\x01\n" +
+	"\vGetRevision\x12 .realworld.v1.GetRevisionRequest\x1a!.realworld.v1.SingleRevisionReply\"1\x82\xd3\xe4\x93\x02+\x12)/api/articles/{slug}/revisions/{revision}\x12\x8d\x01\n" +
+	"\rDiffRevisions\x12\".realworld.v1.DiffRevisionsRequest\x1a\x1f.realworld.v1.RevisionDiffReply\"7\x82\xd3\xe4\x93\x021\x12//api/articles/{slug}/revisions/{from}/diff/{to}\x12\x97\x01\n" +
+	"\x0fRestoreRevision\x12$.realworld.v1.RestoreRevisionRequest\x1a .realworld.v1.SingleArticleReply\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/articles/{slug}/revisions/{revision}/restore\x12i\n" +
 	"\rDeleteArticle\x12\".realworld.v1.DeleteArticleRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/api/articles/{slug}\x12{\n" +
 	"\vAddComments\x12 .realworld.v1.AddCommentsRequest\x1a .realworld.v1.SingleCommentReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/articles/{slug}/comments\x12z\n" +
-	"\vGetComments\x12 .realworld.v1.GetCommentsRequest\x1a\".realworld.v1.MultipleCommentReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/articles/{slug}/comments\x12w\n" +
+	"\vGetComments\x12 .realworld.v1.GetCommentsRequest\x1a\".realworld.v1.MultipleCommentReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/articles/{slug}/comments\x12\x84\x01\n" +
+	"\rUpdateComment\x12\".realworld.v1.UpdateCommentRequest\x1a .realworld.v1.SingleCommentReply\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/articles/{slug}/comments/{id}\x12\x93\x01\n" +
+	"\x10ListCommentEdits\x12%.realworld.v1.ListCommentEditsRequest\x1a&.realworld.v1.MultipleCommentEditReply\"0\x82\xd3\xe4\x93\x02*\x12(/api/articles/{slug}/comments/{id}/edits\x12w\n" +
 	"\rDeleteComment\x12\".realworld.v1.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/api/articles/{slug}/comments/{id}\x12\x80\x01\n" +
 	"\x0fFavoriteArticle\x12$.realworld.v1.FavoriteArticleRequest\x1a .realworld.v1.SingleArticleReply\"%\x82\xd3\xe4\x93\x02\x1f\"\x1d/api/articles/{slug}/favorite\x12\x82\x01\n" +
-	"\x11UnFavoriteArticle\x12$.realworld.v1.FavoriteArticleRequest\x1a .realworld.v1.SingleArticleReply\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/articles/{slug}/favorite\x12Q\n" +
-	"\aGetTags\x12\x16.google.protobuf.Empty\x1a\x1b.realworld.v1.ListTagsReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/tagsBY\n" +
+	"\x11UnFavoriteArticle\x12$.realworld.v1.FavoriteArticleRequest\x1a .realworld.v1.SingleArticleReply\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/articles/{slug}/favorite\x12\x8b\x01\n" +
+	"\x12AddArticleReaction\x12$.realworld.v1.ArticleReactionRequest\x1a\x1c.realworld.v1.ReactionsReply\"1\x82\xd3\xe4\x93\x02+\")/api/articles/{slug}/reactions/{reaction}\x12\x8e\x01\n" +
+	"\x15RemoveArticleReaction\x12$.realworld.v1.ArticleReactionRequest\x1a\x1c.realworld.v1.ReactionsReply\"1\x82\xd3\xe4\x93\x02+*)/api/articles/{slug}/reactions/{reaction}\x12\x99\x01\n" +
+	"\x12AddCommentReaction\x12$.realworld.v1.CommentReactionRequest\x1a\x1c.realworld.v1.ReactionsReply\"?\x82\xd3\xe4\x93\x029\"7/api/articles/{slug}/comments/{id}/reactions/{reaction}\x12\x9c\x01\n" +
+	"\x15RemoveCommentReaction\x12$.realworld.v1.CommentReactionRequest\x1a\x1c.realworld.v1.ReactionsReply\"?\x82\xd3\xe4\x93\x029*7/api/articles/{slug}/comments/{id}/reactions/{reaction}\x12Q\n" +
+	"\aGetTags\x12\x16.google.protobuf.Empty\x1a\x1b.realworld.v1.ListTagsReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/tags\x12\x80\x01\n" +
+	"\x11ListNotifications\x12&.realworld.v1.ListNotificationsRequest\x1a'.realworld.v1.MultipleNotificationReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/notifications\x12z\n" +
+	"\x17UnreadNotificationCount\x12\x16.google.protobuf.Empty\x1a\x1e.realworld.v1.UnreadCountReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/notifications/unread-count\x12\x7f\n" +
+	"\x14MarkNotificationRead\x12).realworld.v1.MarkNotificationReadRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e\"\x1c/api/notifications/{id}/read\x12k\n" +
+	"\x18MarkAllNotificationsRead\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/api/notifications/read\x12g\n" +
+	"\x10CreateLiveTicket\x12\x16.google.protobuf.Empty\x1a\x1d.realworld.v1.LiveTicketReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/live/tickets\x12F\n" +
+	"\tSubscribe\x12\x1e.realworld.v1.SubscribeRequest\x1a\x17.realworld.v1.LiveEvent0\x01\x12k\n" +
+	"\vGetPresence\x12 .realworld.v1.GetPresenceRequest\x1a#.realworld.v1.MultiplePresenceReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/presence\x12\x89\x01\n" +
+	"\x16UpdatePresenceSettings\x12+.realworld.v1.UpdatePresenceSettingsRequest\x1a#.realworld.v1.PresenceSettingsReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/user/presence\x12i\n" +
+	"\rCreateWebhook\x12\".realworld.v1.CreateWebhookRequest\x1a\x1a.realworld.v1.WebhookReply\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/webhooks\x12a\n" +
+	"\fListWebhooks\x12\x16.google.protobuf.Empty\x1a\".realworld.v1.MultipleWebhookReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/webhooks\x12g\n" +
+	"\rDeleteWebhook\x12\".realworld.v1.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/webhooks/{id}\x12\x96\x01\n" +
+	"\x15ListWebhookDeliveries\x12*.realworld.v1.ListWebhookDeliveriesRequest\x1a*.realworld.v1.MultipleWebhookDeliveryReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/webhooks/{id}/deliveriesBY\n" +
 	"\x1cdev.kratos.api.helloworld.v1B\x11HelloworldProtoV1P\x01Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
 
 var (
@@ -2401,108 +7068,270 @@ func file_realworld_v1_realworld_proto_rawDescGZIP() []byte {
 	return file_realworld_v1_realworld_proto_rawDescData
 }
 
-var file_realworld_v1_realworld_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_realworld_v1_realworld_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
 var file_realworld_v1_realworld_proto_goTypes = []any{
-	(*AuthRequest)(nil),                         // 0: realworld.v1.AuthRequest
-	(*RegisterRequest)(nil),                     // 1: realworld.v1.RegisterRequest
-	(*UpdateUserRequest)(nil),                   // 2: realworld.v1.UpdateUserRequest
-	(*GetProfileRequest)(nil),                   // 3: realworld.v1.GetProfileRequest
-	(*FollowUserRequest)(nil),                   // 4: realworld.v1.FollowUserRequest
-	(*ListArticlesRequest)(nil),                 // 5: realworld.v1.ListArticlesRequest
-	(*FeedArticlesRequest)(nil),                 // 6: realworld.v1.FeedArticlesRequest
-	(*GetArticleRequest)(nil),                   // 7: realworld.v1.GetArticleRequest
-	(*DeleteArticleRequest)(nil),                // 8: realworld.v1.DeleteArticleRequest
-	(*CreateArticleRequest)(nil),                // 9: realworld.v1.CreateArticleRequest
-	(*UpdateArticleRequest)(nil),                // 10: realworld.v1.UpdateArticleRequest
-	(*AddCommentsRequest)(nil),                  // 11: realworld.v1.AddCommentsRequest
-	(*GetCommentsRequest)(nil),                  // 12: realworld.v1.GetCommentsRequest
-	(*DeleteCommentRequest)(nil),                // 13: realworld.v1.DeleteCommentRequest
-	(*FavoriteArticleRequest)(nil),              // 14: realworld.v1.FavoriteArticleRequest
-	(*UserReply)(nil),                           // 15: realworld.v1.UserReply
-	(*ProfileReply)(nil),                        // 16: realworld.v1.ProfileReply
-	(*SingleArticleReply)(nil),                  // 17: realworld.v1.SingleArticleReply
-	(*MultipleArticleReply)(nil),                // 18: realworld.v1.MultipleArticleReply
-	(*SingleCommentReply)(nil),                  // 19: realworld.v1.SingleCommentReply
-	(*MultipleCommentReply)(nil),                // 20: realworld.v1.MultipleCommentReply
-	(*ListTagsReply)(nil),                       // 21: realworld.v1.ListTagsReply
-	(*AuthRequest_User)(nil),                    // 22: realworld.v1.AuthRequest.User
-	(*RegisterRequest_User)(nil),                // 23: realworld.v1.RegisterRequest.User
-	(*UpdateUserRequest_User)(nil),              // 24: realworld.v1.UpdateUserRequest.User
-	(*CreateArticleRequest_Article)(nil),        // 25: realworld.v1.CreateArticleRequest.Article
-	(*UpdateArticleRequest_Article)(nil),        // 26: realworld.v1.UpdateArticleRequest.Article
-	(*AddCommentsRequest_Comment)(nil),          // 27: realworld.v1.AddCommentsRequest.Comment
-	(*UserReply_User)(nil),                      // 28: realworld.v1.UserReply.User
-	(*ProfileReply_Profile)(nil),                // 29: realworld.v1.ProfileReply.Profile
-	(*SingleArticleReply_Article)(nil),          // 30: realworld.v1.SingleArticleReply.Article
-	(*SingleArticleReply_Article_Author)(nil),   // 31: realworld.v1.SingleArticleReply.Article.Author
-	(*MultipleArticleReply_Article)(nil),        // 32: realworld.v1.MultipleArticleReply.Article
-	(*MultipleArticleReply_Article_Author)(nil), // 33: realworld.v1.MultipleArticleReply.Article.Author
-	(*SingleCommentReply_Comment)(nil),          // 34: realworld.v1.SingleCommentReply.Comment
-	(*SingleCommentReply_Comment_Author)(nil),   // 35: realworld.v1.SingleCommentReply.Comment.Author
-	(*MultipleCommentReply_Comment)(nil),        // 36: realworld.v1.MultipleCommentReply.Comment
-	(*MultipleCommentReply_Comment_Author)(nil), // 37: realworld.v1.MultipleCommentReply.Comment.Author
-	(*emptypb.Empty)(nil),                       // 38: google.protobuf.Empty
+	(*AuthRequest)(nil),                                  // 0: realworld.v1.AuthRequest
+	(*RegisterRequest)(nil),                              // 1: realworld.v1.RegisterRequest
+	(*UpdateUserRequest)(nil),                            // 2: realworld.v1.UpdateUserRequest
+	(*GetProfileRequest)(nil),                            // 3: realworld.v1.GetProfileRequest
+	(*FollowUserRequest)(nil),                            // 4: realworld.v1.FollowUserRequest
+	(*SearchProfilesRequest)(nil),                        // 5: realworld.v1.SearchProfilesRequest
+	(*ListFollowersRequest)(nil),                         // 6: realworld.v1.ListFollowersRequest
+	(*ListSuggestionsRequest)(nil),                       // 7: realworld.v1.ListSuggestionsRequest
+	(*ListArticlesRequest)(nil),                          // 8: realworld.v1.ListArticlesRequest
+	(*FeedArticlesRequest)(nil),                          // 9: realworld.v1.FeedArticlesRequest
+	(*RelatedArticlesRequest)(nil),                       // 10: realworld.v1.RelatedArticlesRequest
+	(*TrendingArticlesRequest)(nil),                      // 11: realworld.v1.TrendingArticlesRequest
+	(*ListDraftsRequest)(nil),                            // 12: realworld.v1.ListDraftsRequest
+	(*SearchArticlesRequest)(nil),                        // 13: realworld.v1.SearchArticlesRequest
+	(*GetArticleRequest)(nil),                            // 14: realworld.v1.GetArticleRequest
+	(*DeleteArticleRequest)(nil),                         // 15: realworld.v1.DeleteArticleRequest
+	(*CreateArticleRequest)(nil),                         // 16: realworld.v1.CreateArticleRequest
+	(*UpdateArticleRequest)(nil),                         // 17: realworld.v1.UpdateArticleRequest
+	(*AddCommentsRequest)(nil),                           // 18: realworld.v1.AddCommentsRequest
+	(*GetCommentsRequest)(nil),                           // 19: realworld.v1.GetCommentsRequest
+	(*UpdateCommentRequest)(nil),                         // 20: realworld.v1.UpdateCommentRequest
+	(*ListCommentEditsRequest)(nil),                      // 21: realworld.v1.ListCommentEditsRequest
+	(*DeleteCommentRequest)(nil),                         // 22: realworld.v1.DeleteCommentRequest
+	(*FavoriteArticleRequest)(nil),                       // 23: realworld.v1.FavoriteArticleRequest
+	(*ArticleReactionRequest)(nil),                       // 24: realworld.v1.ArticleReactionRequest
+	(*CommentReactionRequest)(nil),                       // 25: realworld.v1.CommentReactionRequest
+	(*PublishArticleRequest)(nil),                        // 26: realworld.v1.PublishArticleRequest
+	(*ScheduleArticleRequest)(nil),                       // 27: realworld.v1.ScheduleArticleRequest
+	(*ArticleStatusRequest)(nil),                         // 28: realworld.v1.ArticleStatusRequest
+	(*ListNotificationsRequest)(nil),                     // 29: realworld.v1.ListNotificationsRequest
+	(*MarkNotificationReadRequest)(nil),                  // 30: realworld.v1.MarkNotificationReadRequest
+	(*GetPresenceRequest)(nil),                           // 31: realworld.v1.GetPresenceRequest
+	(*UpdatePresenceSettingsRequest)(nil),                // 32: realworld.v1.UpdatePresenceSettingsRequest
+	(*CreateWebhookRequest)(nil),                         // 33: realworld.v1.CreateWebhookRequest
+	(*DeleteWebhookRequest)(nil),                         // 34: realworld.v1.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),                 // 35: realworld.v1.ListWebhookDeliveriesRequest
+	(*SubscribeRequest)(nil),                             // 36: realworld.v1.SubscribeRequest
+	(*ListRevisionsRequest)(nil),                         // 37: realworld.v1.ListRevisionsRequest
+	(*GetRevisionRequest)(nil),                           // 38: realworld.v1.GetRevisionRequest
+	(*RestoreRevisionRequest)(nil),                       // 39: realworld.v1.RestoreRevisionRequest
+	(*DiffRevisionsRequest)(nil),                         // 40: realworld.v1.DiffRevisionsRequest
+	(*UserReply)(nil),                                    // 41: realworld.v1.UserReply
+	(*ProfileReply)(nil),                                 // 42: realworld.v1.ProfileReply
+	(*MultipleProfileReply)(nil),                         // 43: realworld.v1.MultipleProfileReply
+	(*Reaction)(nil),                                     // 44: realworld.v1.Reaction
+	(*ReactionsReply)(nil),                               // 45: realworld.v1.ReactionsReply
+	(*Mention)(nil),                                      // 46: realworld.v1.Mention
+	(*SingleArticleReply)(nil),                           // 47: realworld.v1.SingleArticleReply
+	(*MultipleArticleReply)(nil),                         // 48: realworld.v1.MultipleArticleReply
+	(*SearchArticlesReply)(nil),                          // 49: realworld.v1.SearchArticlesReply
+	(*SingleRevisionReply)(nil),                          // 50: realworld.v1.SingleRevisionReply
+	(*MultipleRevisionReply)(nil),                        // 51: realworld.v1.MultipleRevisionReply
+	(*RevisionDiffReply)(nil),                            // 52: realworld.v1.RevisionDiffReply
+	(*SingleCommentReply)(nil),                           // 53: realworld.v1.SingleCommentReply
+	(*MultipleCommentReply)(nil),                         // 54: realworld.v1.MultipleCommentReply
+	(*MultipleCommentEditReply)(nil),                     // 55: realworld.v1.MultipleCommentEditReply
+	(*MultipleNotificationReply)(nil),                    // 56: realworld.v1.MultipleNotificationReply
+	(*UnreadCountReply)(nil),                             // 57: realworld.v1.UnreadCountReply
+	(*LiveTicketReply)(nil),                              // 58: realworld.v1.LiveTicketReply
+	(*MultiplePresenceReply)(nil),                        // 59: realworld.v1.MultiplePresenceReply
+	(*PresenceSettingsReply)(nil),                        // 60: realworld.v1.PresenceSettingsReply
+	(*WebhookReply)(nil),                                 // 61: realworld.v1.WebhookReply
+	(*MultipleWebhookReply)(nil),                         // 62: realworld.v1.MultipleWebhookReply
+	(*MultipleWebhookDeliveryReply)(nil),                 // 63: realworld.v1.MultipleWebhookDeliveryReply
+	(*LiveEvent)(nil),                                    // 64: realworld.v1.LiveEvent
+	(*ListTagsReply)(nil),                                // 65: realworld.v1.ListTagsReply
+	(*AuthRequest_User)(nil),                             // 66: realworld.v1.AuthRequest.User
+	(*RegisterRequest_User)(nil),                         // 67: realworld.v1.RegisterRequest.User
+	(*UpdateUserRequest_User)(nil),                       // 68: realworld.v1.UpdateUserRequest.User
+	(*CreateArticleRequest_Article)(nil),                 // 69: realworld.v1.CreateArticleRequest.Article
+	(*UpdateArticleRequest_Article)(nil),                 // 70: realworld.v1.UpdateArticleRequest.Article
+	(*AddCommentsRequest_Comment)(nil),                   // 71: realworld.v1.AddCommentsRequest.Comment
+	(*UpdateCommentRequest_Comment)(nil),                 // 72: realworld.v1.UpdateCommentRequest.Comment
+	(*CreateWebhookRequest_Webhook)(nil),                 // 73: realworld.v1.CreateWebhookRequest.Webhook
+	(*UserReply_User)(nil),                               // 74: realworld.v1.UserReply.User
+	(*ProfileReply_Profile)(nil),                         // 75: realworld.v1.ProfileReply.Profile
+	(*MultipleProfileReply_Profile)(nil),                 // 76: realworld.v1.MultipleProfileReply.Profile
+	(*SingleArticleReply_Article)(nil),                   // 77: realworld.v1.SingleArticleReply.Article
+	(*SingleArticleReply_Article_Author)(nil),            // 78: realworld.v1.SingleArticleReply.Article.Author
+	(*SingleArticleReply_Article_Heading)(nil),           // 79: realworld.v1.SingleArticleReply.Article.Heading
+	(*MultipleArticleReply_Article)(nil),                 // 80: realworld.v1.MultipleArticleReply.Article
+	(*MultipleArticleReply_Article_Author)(nil),          // 81: realworld.v1.MultipleArticleReply.Article.Author
+	(*SearchArticlesReply_Article)(nil),                  // 82: realworld.v1.SearchArticlesReply.Article
+	(*SearchArticlesReply_Article_Author)(nil),           // 83: realworld.v1.SearchArticlesReply.Article.Author
+	(*SingleRevisionReply_Revision)(nil),                 // 84: realworld.v1.SingleRevisionReply.Revision
+	(*SingleRevisionReply_Revision_Editor)(nil),          // 85: realworld.v1.SingleRevisionReply.Revision.Editor
+	(*MultipleRevisionReply_Revision)(nil),               // 86: realworld.v1.MultipleRevisionReply.Revision
+	(*MultipleRevisionReply_Revision_Editor)(nil),        // 87: realworld.v1.MultipleRevisionReply.Revision.Editor
+	(*SingleCommentReply_Comment)(nil),                   // 88: realworld.v1.SingleCommentReply.Comment
+	(*SingleCommentReply_Comment_Author)(nil),            // 89: realworld.v1.SingleCommentReply.Comment.Author
+	(*MultipleCommentReply_Comment)(nil),                 // 90: realworld.v1.MultipleCommentReply.Comment
+	(*MultipleCommentReply_Comment_Author)(nil),          // 91: realworld.v1.MultipleCommentReply.Comment.Author
+	(*MultipleCommentEditReply_Edit)(nil),                // 92: realworld.v1.MultipleCommentEditReply.Edit
+	(*MultipleNotificationReply_Notification)(nil),       // 93: realworld.v1.MultipleNotificationReply.Notification
+	(*MultipleNotificationReply_Notification_Actor)(nil), // 94: realworld.v1.MultipleNotificationReply.Notification.Actor
+	(*MultiplePresenceReply_Presence)(nil),               // 95: realworld.v1.MultiplePresenceReply.Presence
+	(*WebhookReply_Webhook)(nil),                         // 96: realworld.v1.WebhookReply.Webhook
+	(*MultipleWebhookDeliveryReply_Delivery)(nil),        // 97: realworld.v1.MultipleWebhookDeliveryReply.Delivery
+	(*LiveEvent_Comment)(nil),                            // 98: realworld.v1.LiveEvent.Comment
+	(*LiveEvent_Notification)(nil),                       // 99: realworld.v1.LiveEvent.Notification
+	(*LiveEvent_Feed)(nil),                               // 100: realworld.v1.LiveEvent.Feed
+	(*LiveEvent_Presence)(nil),                           // 101: realworld.v1.LiveEvent.Presence
+	(*fieldmaskpb.FieldMask)(nil),                        // 102: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                                // 103: google.protobuf.Empty
 }
 var file_realworld_v1_realworld_proto_depIdxs = []int32{
-	22, // 0: realworld.v1.AuthRequest.user:type_name -> realworld.v1.AuthRequest.User
-	23, // 1: realworld.v1.RegisterRequest.user:type_name -> realworld.v1.RegisterRequest.User
-	24, // 2: realworld.v1.UpdateUserRequest.user:type_name -> realworld.v1.UpdateUserRequest.User
-	25, // 3: realworld.v1.CreateArticleRequest.article:type_name -> realworld.v1.CreateArticleRequest.Article
-	26, // 4: realworld.v1.UpdateArticleRequest.article:type_name -> realworld.v1.UpdateArticleRequest.Article
-	27, // 5: realworld.v1.AddCommentsRequest.comment:type_name -> realworld.v1.AddCommentsRequest.Comment
-	28, // 6: realworld.v1.UserReply.user:type_name -> realworld.v1.UserReply.User
-	29, // 7: realworld.v1.ProfileReply.profile:type_name -> realworld.v1.ProfileReply.Profile
-	30, // 8: realworld.v1.SingleArticleReply.article:type_name -> realworld.v1.SingleArticleReply.Article
-	32, // 9: realworld.v1.MultipleArticleReply.articles:type_name -> realworld.v1.MultipleArticleReply.Article
-	34, // 10: realworld.v1.SingleCommentReply.comment:type_name -> realworld.v1.SingleCommentReply.Comment
-	36, // 11: realworld.v1.MultipleCommentReply.comments:type_name -> realworld.v1.MultipleCommentReply.Comment
-	31, // 12: realworld.v1.SingleArticleReply.Article.author:type_name -> realworld.v1.SingleArticleReply.Article.Author
-	33, // 13: realworld.v1.MultipleArticleReply.Article.author:type_name -> realworld.v1.MultipleArticleReply.Article.Author
-	35, // 14: realworld.v1.SingleCommentReply.Comment.author:type_name -> realworld.v1.SingleCommentReply.Comment.Author
-	37, // 15: realworld.v1.MultipleCommentReply.Comment.author:type_name -> realworld.v1.MultipleCommentReply.Comment.Author
-	0,  // 16: realworld.v1.RealWorld.Login:input_type -> realworld.v1.AuthRequest
-	1,  // 17: realworld.v1.RealWorld.Register:input_type -> realworld.v1.RegisterRequest
-	38, // 18: realworld.v1.RealWorld.GetCurrentUser:input_type -> google.protobuf.Empty
-	2,  // 19: realworld.v1.RealWorld.UpdateUser:input_type -> realworld.v1.UpdateUserRequest
-	3,  // 20: realworld.v1.RealWorld.GetProfile:input_type -> realworld.v1.GetProfileRequest
-	4,  // 21: realworld.v1.RealWorld.FollowUser:input_type -> realworld.v1.FollowUserRequest
-	4,  // 22: realworld.v1.RealWorld.UnFollowUser:input_type -> realworld.v1.FollowUserRequest
-	5,  // 23: realworld.v1.RealWorld.ListArticles:input_type -> realworld.v1.ListArticlesRequest
-	6,  // 24: realworld.v1.RealWorld.FeedArticles:input_type -> realworld.v1.FeedArticlesRequest
-	7,  // 25: realworld.v1.RealWorld.GetArticle:input_type -> realworld.v1.GetArticleRequest
-	9,  // 26: realworld.v1.RealWorld.CreateArticle:input_type -> realworld.v1.CreateArticleRequest
-	10, // 27: realworld.v1.RealWorld.UpdateArticle:input_type -> realworld.v1.UpdateArticleRequest
-	8,  // 28: realworld.v1.RealWorld.DeleteArticle:input_type -> realworld.v1.DeleteArticleRequest
-	11, // 29: realworld.v1.RealWorld.AddComments:input_type -> realworld.v1.AddCommentsRequest
-	12, // 30: realworld.v1.RealWorld.GetComments:input_type -> realworld.v1.GetCommentsRequest
-	13, // 31: realworld.v1.RealWorld.DeleteComment:input_type -> realworld.v1.DeleteCommentRequest
-	14, // 32: realworld.v1.RealWorld.FavoriteArticle:input_type -> realworld.v1.FavoriteArticleRequest
-	14, // 33: realworld.v1.RealWorld.UnFavoriteArticle:input_type -> realworld.v1.FavoriteArticleRequest
-	38, // 34: realworld.v1.RealWorld.GetTags:input_type -> google.protobuf.Empty
-	15, // 35: realworld.v1.RealWorld.Login:output_type -> realworld.v1.UserReply
-	15, // 36: realworld.v1.RealWorld.Register:output_type -> realworld.v1.UserReply
-	15, // 37: realworld.v1.RealWorld.GetCurrentUser:output_type -> realworld.v1.UserReply
-	15, // 38: realworld.v1.RealWorld.UpdateUser:output_type -> realworld.v1.UserReply
-	16, // 39: realworld.v1.RealWorld.GetProfile:output_type -> realworld.v1.ProfileReply
-	16, // 40: realworld.v1.RealWorld.FollowUser:output_type -> realworld.v1.ProfileReply
-	16, // 41: realworld.v1.RealWorld.UnFollowUser:output_type -> realworld.v1.ProfileReply
-	18, // 42: realworld.v1.RealWorld.ListArticles:output_type -> realworld.v1.MultipleArticleReply
-	18, // 43: realworld.v1.RealWorld.FeedArticles:output_type -> realworld.v1.MultipleArticleReply
-	17, // 44: realworld.v1.RealWorld.GetArticle:output_type -> realworld.v1.SingleArticleReply
-	17, // 45: realworld.v1.RealWorld.CreateArticle:output_type -> realworld.v1.SingleArticleReply
-	17, // 46: realworld.v1.RealWorld.UpdateArticle:output_type -> realworld.v1.SingleArticleReply
-	38, // 47: realworld.v1.RealWorld.DeleteArticle:output_type -> google.protobuf.Empty
-	19, // 48: realworld.v1.RealWorld.AddComments:output_type -> realworld.v1.SingleCommentReply
-	20, // 49: realworld.v1.RealWorld.GetComments:output_type -> realworld.v1.MultipleCommentReply
-	38, // 50: realworld.v1.RealWorld.DeleteComment:output_type -> google.protobuf.Empty
-	17, // 51: realworld.v1.RealWorld.FavoriteArticle:output_type -> realworld.v1.SingleArticleReply
-	17, // 52: realworld.v1.RealWorld.UnFavoriteArticle:output_type -> realworld.v1.SingleArticleReply
-	21, // 53: realworld.v1.RealWorld.GetTags:output_type -> realworld.v1.ListTagsReply
-	35, // [35:54] is the sub-list for method output_type
-	16, // [16:35] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	66,  // 0: realworld.v1.AuthRequest.user:type_name -> realworld.v1.AuthRequest.User
+	67,  // 1: realworld.v1.RegisterRequest.user:type_name -> realworld.v1.RegisterRequest.User
+	68,  // 2: realworld.v1.UpdateUserRequest.user:type_name -> realworld.v1.UpdateUserRequest.User
+	102, // 3: realworld.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	69,  // 4: realworld.v1.CreateArticleRequest.article:type_name -> realworld.v1.CreateArticleRequest.Article
+	70,  // 5: realworld.v1.UpdateArticleRequest.article:type_name -> realworld.v1.UpdateArticleRequest.Article
+	102, // 6: realworld.v1.UpdateArticleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	71,  // 7: realworld.v1.AddCommentsRequest.comment:type_name -> realworld.v1.AddCommentsRequest.Comment
+	72,  // 8: realworld.v1.UpdateCommentRequest.comment:type_name -> realworld.v1.UpdateCommentRequest.Comment
+	73,  // 9: realworld.v1.CreateWebhookRequest.webhook:type_name -> realworld.v1.CreateWebhookRequest.Webhook
+	74,  // 10: realworld.v1.UserReply.user:type_name -> realworld.v1.UserReply.User
+	75,  // 11: realworld.v1.ProfileReply.profile:type_name -> realworld.v1.ProfileReply.Profile
+	76,  // 12: realworld.v1.MultipleProfileReply.profiles:type_name -> realworld.v1.MultipleProfileReply.Profile
+	44,  // 13: realworld.v1.ReactionsReply.reactions:type_name -> realworld.v1.Reaction
+	77,  // 14: realworld.v1.SingleArticleReply.article:type_name -> realworld.v1.SingleArticleReply.Article
+	80,  // 15: realworld.v1.MultipleArticleReply.articles:type_name -> realworld.v1.MultipleArticleReply.Article
+	82,  // 16: realworld.v1.SearchArticlesReply.articles:type_name -> realworld.v1.SearchArticlesReply.Article
+	84,  // 17: realworld.v1.SingleRevisionReply.revision:type_name -> realworld.v1.SingleRevisionReply.Revision
+	86,  // 18: realworld.v1.MultipleRevisionReply.revisions:type_name -> realworld.v1.MultipleRevisionReply.Revision
+	88,  // 19: realworld.v1.SingleCommentReply.comment:type_name -> realworld.v1.SingleCommentReply.Comment
+	90,  // 20: realworld.v1.MultipleCommentReply.comments:type_name -> realworld.v1.MultipleCommentReply.Comment
+	92,  // 21: realworld.v1.MultipleCommentEditReply.edits:type_name -> realworld.v1.MultipleCommentEditReply.Edit
+	93,  // 22: realworld.v1.MultipleNotificationReply.notifications:type_name -> realworld.v1.MultipleNotificationReply.Notification
+	95,  // 23: realworld.v1.MultiplePresenceReply.presences:type_name -> realworld.v1.MultiplePresenceReply.Presence
+	96,  // 24: realworld.v1.WebhookReply.webhook:type_name -> realworld.v1.WebhookReply.Webhook
+	96,  // 25: realworld.v1.MultipleWebhookReply.webhooks:type_name -> realworld.v1.WebhookReply.Webhook
+	97,  // 26: realworld.v1.MultipleWebhookDeliveryReply.deliveries:type_name -> realworld.v1.MultipleWebhookDeliveryReply.Delivery
+	98,  // 27: realworld.v1.LiveEvent.comment:type_name -> realworld.v1.LiveEvent.Comment
+	99,  // 28: realworld.v1.LiveEvent.notification:type_name -> realworld.v1.LiveEvent.Notification
+	100, // 29: realworld.v1.LiveEvent.feed:type_name -> realworld.v1.LiveEvent.Feed
+	101, // 30: realworld.v1.LiveEvent.presence:type_name -> realworld.v1.LiveEvent.Presence
+	78,  // 31: realworld.v1.SingleArticleReply.Article.author:type_name -> realworld.v1.SingleArticleReply.Article.Author
+	79,  // 32: realworld.v1.SingleArticleReply.Article.toc:type_name -> realworld.v1.SingleArticleReply.Article.Heading
+	44,  // 33: realworld.v1.SingleArticleReply.Article.reactions:type_name -> realworld.v1.Reaction
+	46,  // 34: realworld.v1.SingleArticleReply.Article.mentions:type_name -> realworld.v1.Mention
+	81,  // 35: realworld.v1.MultipleArticleReply.Article.author:type_name -> realworld.v1.MultipleArticleReply.Article.Author
+	44,  // 36: realworld.v1.MultipleArticleReply.Article.reactions:type_name -> realworld.v1.Reaction
+	83,  // 37: realworld.v1.SearchArticlesReply.Article.author:type_name -> realworld.v1.SearchArticlesReply.Article.Author
+	85,  // 38: realworld.v1.SingleRevisionReply.Revision.editor:type_name -> realworld.v1.SingleRevisionReply.Revision.Editor
+	87,  // 39: realworld.v1.MultipleRevisionReply.Revision.editor:type_name -> realworld.v1.MultipleRevisionReply.Revision.Editor
+	89,  // 40: realworld.v1.SingleCommentReply.Comment.author:type_name -> realworld.v1.SingleCommentReply.Comment.Author
+	44,  // 41: realworld.v1.SingleCommentReply.Comment.reactions:type_name -> realworld.v1.Reaction
+	46,  // 42: realworld.v1.SingleCommentReply.Comment.mentions:type_name -> realworld.v1.Mention
+	91,  // 43: realworld.v1.MultipleCommentReply.Comment.author:type_name -> realworld.v1.MultipleCommentReply.Comment.Author
+	44,  // 44: realworld.v1.MultipleCommentReply.Comment.reactions:type_name -> realworld.v1.Reaction
+	46,  // 45: realworld.v1.MultipleCommentReply.Comment.mentions:type_name -> realworld.v1.Mention
+	94,  // 46: realworld.v1.MultipleNotificationReply.Notification.actors:type_name -> realworld.v1.MultipleNotificationReply.Notification.Actor
+	0,   // 47: realworld.v1.RealWorld.Login:input_type -> realworld.v1.AuthRequest
+	1,   // 48: realworld.v1.RealWorld.Register:input_type -> realworld.v1.RegisterRequest
+	103, // 49: realworld.v1.RealWorld.GetCurrentUser:input_type -> google.protobuf.Empty
+	2,   // 50: realworld.v1.RealWorld.UpdateUser:input_type -> realworld.v1.UpdateUserRequest
+	6,   // 51: realworld.v1.RealWorld.ListFollowers:input_type -> realworld.v1.ListFollowersRequest
+	5,   // 52: realworld.v1.RealWorld.SearchProfiles:input_type -> realworld.v1.SearchProfilesRequest
+	7,   // 53: realworld.v1.RealWorld.ListSuggestions:input_type -> realworld.v1.ListSuggestionsRequest
+	3,   // 54: realworld.v1.RealWorld.GetProfile:input_type -> realworld.v1.GetProfileRequest
+	4,   // 55: realworld.v1.RealWorld.FollowUser:input_type -> realworld.v1.FollowUserRequest
+	4,   // 56: realworld.v1.RealWorld.UnFollowUser:input_type -> realworld.v1.FollowUserRequest
+	8,   // 57: realworld.v1.RealWorld.ListArticles:input_type -> realworld.v1.ListArticlesRequest
+	11,  // 58: realworld.v1.RealWorld.TrendingArticles:input_type -> realworld.v1.TrendingArticlesRequest
+	9,   // 59: realworld.v1.RealWorld.FeedArticles:input_type -> realworld.v1.FeedArticlesRequest
+	12,  // 60: realworld.v1.RealWorld.ListDrafts:input_type -> realworld.v1.ListDraftsRequest
+	13,  // 61: realworld.v1.RealWorld.SearchArticles:input_type -> realworld.v1.SearchArticlesRequest
+	14,  // 62: realworld.v1.RealWorld.GetArticle:input_type -> realworld.v1.GetArticleRequest
+	10,  // 63: realworld.v1.RealWorld.RelatedArticles:input_type -> realworld.v1.RelatedArticlesRequest
+	16,  // 64: realworld.v1.RealWorld.CreateArticle:input_type -> realworld.v1.CreateArticleRequest
+	17,  // 65: realworld.v1.RealWorld.UpdateArticle:input_type -> realworld.v1.UpdateArticleRequest
+	26,  // 66: realworld.v1.RealWorld.PublishArticle:input_type -> realworld.v1.PublishArticleRequest
+	27,  // 67: realworld.v1.RealWorld.ScheduleArticle:input_type -> realworld.v1.ScheduleArticleRequest
+	28,  // 68: realworld.v1.RealWorld.UnpublishArticle:input_type -> realworld.v1.ArticleStatusRequest
+	28,  // 69: realworld.v1.RealWorld.ArchiveArticle:input_type -> realworld.v1.ArticleStatusRequest
+	37,  // 70: realworld.v1.RealWorld.ListRevisions:input_type -> realworld.v1.ListRevisionsRequest
+	38,  // 71: realworld.v1.RealWorld.GetRevision:input_type -> realworld.v1.GetRevisionRequest
+	40,  // 72: realworld.v1.RealWorld.DiffRevisions:input_type -> realworld.v1.DiffRevisionsRequest
+	39,  // 73: realworld.v1.RealWorld.RestoreRevision:input_type -> realworld.v1.RestoreRevisionRequest
+	15,  // 74: realworld.v1.RealWorld.DeleteArticle:input_type -> realworld.v1.DeleteArticleRequest
+	18,  // 75: realworld.v1.RealWorld.AddComments:input_type -> realworld.v1.AddCommentsRequest
+	19,  // 76: realworld.v1.RealWorld.GetComments:input_type -> realworld.v1.GetCommentsRequest
+	20,  // 77: realworld.v1.RealWorld.UpdateComment:input_type -> realworld.v1.UpdateCommentRequest
+	21,  // 78: realworld.v1.RealWorld.ListCommentEdits:input_type -> realworld.v1.ListCommentEditsRequest
+	22,  // 79: realworld.v1.RealWorld.DeleteComment:input_type -> realworld.v1.DeleteCommentRequest
+	23,  // 80: realworld.v1.RealWorld.FavoriteArticle:input_type -> realworld.v1.FavoriteArticleRequest
+	23,  // 81: realworld.v1.RealWorld.UnFavoriteArticle:input_type -> realworld.v1.FavoriteArticleRequest
+	24,  // 82: realworld.v1.RealWorld.AddArticleReaction:input_type -> realworld.v1.ArticleReactionRequest
+	24,  // 83: realworld.v1.RealWorld.RemoveArticleReaction:input_type -> realworld.v1.ArticleReactionRequest
+	25,  // 84: realworld.v1.RealWorld.AddCommentReaction:input_type -> realworld.v1.CommentReactionRequest
+	25,  // 85: realworld.v1.RealWorld.RemoveCommentReaction:input_type -> realworld.v1.CommentReactionRequest
+	103, // 86: realworld.v1.RealWorld.GetTags:input_type -> google.protobuf.Empty
+	29,  // 87: realworld.v1.RealWorld.ListNotifications:input_type -> realworld.v1.ListNotificationsRequest
+	103, // 88: realworld.v1.RealWorld.UnreadNotificationCount:input_type -> google.protobuf.Empty
+	30,  // 89: realworld.v1.RealWorld.MarkNotificationRead:input_type -> realworld.v1.MarkNotificationReadRequest
+	103, // 90: realworld.v1.RealWorld.MarkAllNotificationsRead:input_type -> google.protobuf.Empty
+	103, // 91: realworld.v1.RealWorld.CreateLiveTicket:input_type -> google.protobuf.Empty
+	36,  // 92: realworld.v1.RealWorld.Subscribe:input_type -> realworld.v1.SubscribeRequest
+	31,  // 93: realworld.v1.RealWorld.GetPresence:input_type -> realworld.v1.GetPresenceRequest
+	32,  // 94: realworld.v1.RealWorld.UpdatePresenceSettings:input_type -> realworld.v1.UpdatePresenceSettingsRequest
+	33,  // 95: realworld.v1.RealWorld.CreateWebhook:input_type -> realworld.v1.CreateWebhookRequest
+	103, // 96: realworld.v1.RealWorld.ListWebhooks:input_type -> google.protobuf.Empty
+	34,  // 97: realworld.v1.RealWorld.DeleteWebhook:input_type -> realworld.v1.DeleteWebhookRequest
+	35,  // 98: realworld.v1.RealWorld.ListWebhookDeliveries:input_type -> realworld.v1.ListWebhookDeliveriesRequest
+	41,  // 99: realworld.v1.RealWorld.Login:output_type -> realworld.v1.UserReply
+	41,  // 100: realworld.v1.RealWorld.Register:output_type -> realworld.v1.UserReply
+	41,  // 101: realworld.v1.RealWorld.GetCurrentUser:output_type -> realworld.v1.UserReply
+	41,  // 102: realworld.v1.RealWorld.UpdateUser:output_type -> realworld.v1.UserReply
+	43,  // 103: realworld.v1.RealWorld.ListFollowers:output_type -> realworld.v1.MultipleProfileReply
+	43,  // 104: realworld.v1.RealWorld.SearchProfiles:output_type -> realworld.v1.MultipleProfileReply
+	43,  // 105: realworld.v1.RealWorld.ListSuggestions:output_type -> realworld.v1.MultipleProfileReply
+	42,  // 106: realworld.v1.RealWorld.GetProfile:output_type -> realworld.v1.ProfileReply
+	42,  // 107: realworld.v1.RealWorld.FollowUser:output_type -> realworld.v1.ProfileReply
+	42,  // 108: realworld.v1.RealWorld.UnFollowUser:output_type -> realworld.v1.ProfileReply
+	48,  // 109: realworld.v1.RealWorld.ListArticles:output_type -> realworld.v1.MultipleArticleReply
+	48,  // 110: realworld.v1.RealWorld.TrendingArticles:output_type -> realworld.v1.MultipleArticleReply
+	48,  // 111: realworld.v1.RealWorld.FeedArticles:output_type -> realworld.v1.MultipleArticleReply
+	48,  // 112: realworld.v1.RealWorld.ListDrafts:output_type -> realworld.v1.MultipleArticleReply
+	49,  // 113: realworld.v1.RealWorld.SearchArticles:output_type -> realworld.v1.SearchArticlesReply
+	47,  // 114: realworld.v1.RealWorld.GetArticle:output_type -> realworld.v1.SingleArticleReply
+	48,  // 115: realworld.v1.RealWorld.RelatedArticles:output_type -> realworld.v1.MultipleArticleReply
+	47,  // 116: realworld.v1.RealWorld.CreateArticle:output_type -> realworld.v1.SingleArticleReply
+	47,  // 117: realworld.v1.RealWorld.UpdateArticle:output_type -> realworld.v1.SingleArticleReply
+	47,  // 118: realworld.v1.RealWorld.PublishArticle:output_type -> realworld.v1.SingleArticleReply
+	47,  // 119: realworld.v1.RealWorld.ScheduleArticle:output_type -> realworld.v1.SingleArticleReply
+	47,  // 120: realworld.v1.RealWorld.UnpublishArticle:output_type -> realworld.v1.SingleArticleReply
+	47,  // 121: realworld.v1.RealWorld.ArchiveArticle:output_type -> realworld.v1.SingleArticleReply
+	51,  // 122: realworld.v1.RealWorld.ListRevisions:output_type -> realworld.v1.MultipleRevisionReply
+	50,  // 123: realworld.v1.RealWorld.GetRevision:output_type -> realworld.v1.SingleRevisionReply
+	52,  // 124: realworld.v1.RealWorld.DiffRevisions:output_type -> realworld.v1.RevisionDiffReply
+	47,  // 125: realworld.v1.RealWorld.RestoreRevision:output_type -> realworld.v1.SingleArticleReply
+	103, // 126: realworld.v1.RealWorld.DeleteArticle:output_type -> google.protobuf.Empty
+	53,  // 127: realworld.v1.RealWorld.AddComments:output_type -> realworld.v1.SingleCommentReply
+	54,  // 128: realworld.v1.RealWorld.GetComments:output_type -> realworld.v1.MultipleCommentReply
+	53,  // 129: realworld.v1.RealWorld.UpdateComment:output_type -> realworld.v1.SingleCommentReply
+	55,  // 130: realworld.v1.RealWorld.ListCommentEdits:output_type -> realworld.v1.MultipleCommentEditReply
+	103, // 131: realworld.v1.RealWorld.DeleteComment:output_type -> google.protobuf.Empty
+	47,  // 132: realworld.v1.RealWorld.FavoriteArticle:output_type -> realworld.v1.SingleArticleReply
+	47,  // 133: realworld.v1.RealWorld.UnFavoriteArticle:output_type -> realworld.v1.SingleArticleReply
+	45,  // 134: realworld.v1.RealWorld.AddArticleReaction:output_type -> realworld.v1.ReactionsReply
+	45,  // 135: realworld.v1.RealWorld.RemoveArticleReaction:output_type -> realworld.v1.ReactionsReply
+	45,  // 136: realworld.v1.RealWorld.AddCommentReaction:output_type -> realworld.v1.ReactionsReply
+	45,  // 137: realworld.v1.RealWorld.RemoveCommentReaction:output_type -> realworld.v1.ReactionsReply
+	65,  // 138: realworld.v1.RealWorld.GetTags:output_type -> realworld.v1.ListTagsReply
+	56,  // 139: realworld.v1.RealWorld.ListNotifications:output_type -> realworld.v1.MultipleNotificationReply
+	57,  // 140: realworld.v1.RealWorld.UnreadNotificationCount:output_type -> realworld.v1.UnreadCountReply
+	103, // 141: realworld.v1.RealWorld.MarkNotificationRead:output_type -> google.protobuf.Empty
+	103, // 142: realworld.v1.RealWorld.MarkAllNotificationsRead:output_type -> google.protobuf.Empty
+	58,  // 143: realworld.v1.RealWorld.CreateLiveTicket:output_type -> realworld.v1.LiveTicketReply
+	64,  // 144: realworld.v1.RealWorld.Subscribe:output_type -> realworld.v1.LiveEvent
+	59,  // 145: realworld.v1.RealWorld.GetPresence:output_type -> realworld.v1.MultiplePresenceReply
+	60,  // 146: realworld.v1.RealWorld.UpdatePresenceSettings:output_type -> realworld.v1.PresenceSettingsReply
+	61,  // 147: realworld.v1.RealWorld.CreateWebhook:output_type -> realworld.v1.WebhookReply
+	62,  // 148: realworld.v1.RealWorld.ListWebhooks:output_type -> realworld.v1.MultipleWebhookReply
+	103, // 149: realworld.v1.RealWorld.DeleteWebhook:output_type -> google.protobuf.Empty
+	63,  // 150: realworld.v1.RealWorld.ListWebhookDeliveries:output_type -> realworld.v1.MultipleWebhookDeliveryReply
+	99,  // [99:151] is the sub-list for method output_type
+	47,  // [47:99] is the sub-list for method input_type
+	47,  // [47:47] is the sub-list for extension type_name
+	47,  // [47:47] is the sub-list for extension extendee
+	0,   // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_realworld_v1_realworld_proto_init() }
@@ -2510,13 +7339,19 @@ func file_realworld_v1_realworld_proto_init() {
 	if File_realworld_v1_realworld_proto != nil {
 		return
 	}
+	file_realworld_v1_realworld_proto_msgTypes[64].OneofWrappers = []any{
+		(*LiveEvent_Comment_)(nil),
+		(*LiveEvent_Notification_)(nil),
+		(*LiveEvent_Feed_)(nil),
+		(*LiveEvent_Presence_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_realworld_v1_realworld_proto_rawDesc), len(file_realworld_v1_realworld_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   102,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
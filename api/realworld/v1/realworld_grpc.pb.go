@@ -20,25 +20,58 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RealWorld_Login_FullMethodName             = "/realworld.v1.RealWorld/Login"
-	RealWorld_Register_FullMethodName          = "/realworld.v1.RealWorld/Register"
-	RealWorld_GetCurrentUser_FullMethodName    = "/realworld.v1.RealWorld/GetCurrentUser"
-	RealWorld_UpdateUser_FullMethodName        = "/realworld.v1.RealWorld/UpdateUser"
-	RealWorld_GetProfile_FullMethodName        = "/realworld.v1.RealWorld/GetProfile"
-	RealWorld_FollowUser_FullMethodName        = "/realworld.v1.RealWorld/FollowUser"
-	RealWorld_UnFollowUser_FullMethodName      = "/realworld.v1.RealWorld/UnFollowUser"
-	RealWorld_ListArticles_FullMethodName      = "/realworld.v1.RealWorld/ListArticles"
-	RealWorld_FeedArticles_FullMethodName      = "/realworld.v1.RealWorld/FeedArticles"
-	RealWorld_GetArticle_FullMethodName        = "/realworld.v1.RealWorld/GetArticle"
-	RealWorld_CreateArticle_FullMethodName     = "/realworld.v1.RealWorld/CreateArticle"
-	RealWorld_UpdateArticle_FullMethodName     = "/realworld.v1.RealWorld/UpdateArticle"
-	RealWorld_DeleteArticle_FullMethodName     = "/realworld.v1.RealWorld/DeleteArticle"
-	RealWorld_AddComments_FullMethodName       = "/realworld.v1.RealWorld/AddComments"
-	RealWorld_GetComments_FullMethodName       = "/realworld.v1.RealWorld/GetComments"
-	RealWorld_DeleteComment_FullMethodName     = "/realworld.v1.RealWorld/DeleteComment"
-	RealWorld_FavoriteArticle_FullMethodName   = "/realworld.v1.RealWorld/FavoriteArticle"
-	RealWorld_UnFavoriteArticle_FullMethodName = "/realworld.v1.RealWorld/UnFavoriteArticle"
-	RealWorld_GetTags_FullMethodName           = "/realworld.v1.RealWorld/GetTags"
+	RealWorld_Login_FullMethodName                    = "/realworld.v1.RealWorld/Login"
+	RealWorld_Register_FullMethodName                 = "/realworld.v1.RealWorld/Register"
+	RealWorld_GetCurrentUser_FullMethodName           = "/realworld.v1.RealWorld/GetCurrentUser"
+	RealWorld_UpdateUser_FullMethodName               = "/realworld.v1.RealWorld/UpdateUser"
+	RealWorld_ListFollowers_FullMethodName            = "/realworld.v1.RealWorld/ListFollowers"
+	RealWorld_SearchProfiles_FullMethodName           = "/realworld.v1.RealWorld/SearchProfiles"
+	RealWorld_ListSuggestions_FullMethodName          = "/realworld.v1.RealWorld/ListSuggestions"
+	RealWorld_GetProfile_FullMethodName               = "/realworld.v1.RealWorld/GetProfile"
+	RealWorld_FollowUser_FullMethodName               = "/realworld.v1.RealWorld/FollowUser"
+	RealWorld_UnFollowUser_FullMethodName             = "/realworld.v1.RealWorld/UnFollowUser"
+	RealWorld_ListArticles_FullMethodName             = "/realworld.v1.RealWorld/ListArticles"
+	RealWorld_TrendingArticles_FullMethodName         = "/realworld.v1.RealWorld/TrendingArticles"
+	RealWorld_FeedArticles_FullMethodName             = "/realworld.v1.RealWorld/FeedArticles"
+	RealWorld_ListDrafts_FullMethodName               = "/realworld.v1.RealWorld/ListDrafts"
+	RealWorld_SearchArticles_FullMethodName           = "/realworld.v1.RealWorld/SearchArticles"
+	RealWorld_GetArticle_FullMethodName               = "/realworld.v1.RealWorld/GetArticle"
+	RealWorld_RelatedArticles_FullMethodName          = "/realworld.v1.RealWorld/RelatedArticles"
+	RealWorld_CreateArticle_FullMethodName            = "/realworld.v1.RealWorld/CreateArticle"
+	RealWorld_UpdateArticle_FullMethodName            = "/realworld.v1.RealWorld/UpdateArticle"
+	RealWorld_PublishArticle_FullMethodName           = "/realworld.v1.RealWorld/PublishArticle"
+	RealWorld_ScheduleArticle_FullMethodName          = "/realworld.v1.RealWorld/ScheduleArticle"
+	RealWorld_UnpublishArticle_FullMethodName         = "/realworld.v1.RealWorld/UnpublishArticle"
+	RealWorld_ArchiveArticle_FullMethodName           = "/realworld.v1.RealWorld/ArchiveArticle"
+	RealWorld_ListRevisions_FullMethodName            = "/realworld.v1.RealWorld/ListRevisions"
+	RealWorld_GetRevision_FullMethodName              = "/realworld.v1.RealWorld/GetRevision"
+	RealWorld_DiffRevisions_FullMethodName            = "/realworld.v1.RealWorld/DiffRevisions"
+	RealWorld_RestoreRevision_FullMethodName          = "/realworld.v1.RealWorld/RestoreRevision"
+	RealWorld_DeleteArticle_FullMethodName            = "/realworld.v1.RealWorld/DeleteArticle"
+	RealWorld_AddComments_FullMethodName              = "/realworld.v1.RealWorld/AddComments"
+	RealWorld_GetComments_FullMethodName              = "/realworld.v1.RealWorld/GetComments"
+	RealWorld_UpdateComment_FullMethodName            = "/realworld.v1.RealWorld/UpdateComment"
+	RealWorld_ListCommentEdits_FullMethodName         = "/realworld.v1.RealWorld/ListCommentEdits"
+	RealWorld_DeleteComment_FullMethodName            = "/realworld.v1.RealWorld/DeleteComment"
+	RealWorld_FavoriteArticle_FullMethodName          = "/realworld.v1.RealWorld/FavoriteArticle"
+	RealWorld_UnFavoriteArticle_FullMethodName        = "/realworld.v1.RealWorld/UnFavoriteArticle"
+	RealWorld_AddArticleReaction_FullMethodName       = "/realworld.v1.RealWorld/AddArticleReaction"
+	RealWorld_RemoveArticleReaction_FullMethodName    = "/realworld.v1.RealWorld/RemoveArticleReaction"
+	RealWorld_AddCommentReaction_FullMethodName       = "/realworld.v1.RealWorld/AddCommentReaction"
+	RealWorld_RemoveCommentReaction_FullMethodName    = "/realworld.v1.RealWorld/RemoveCommentReaction"
+	RealWorld_GetTags_FullMethodName                  = "/realworld.v1.RealWorld/GetTags"
+	RealWorld_ListNotifications_FullMethodName        = "/realworld.v1.RealWorld/ListNotifications"
+	RealWorld_UnreadNotificationCount_FullMethodName  = "/realworld.v1.RealWorld/UnreadNotificationCount"
+	RealWorld_MarkNotificationRead_FullMethodName     = "/realworld.v1.RealWorld/MarkNotificationRead"
+	RealWorld_MarkAllNotificationsRead_FullMethodName = "/realworld.v1.RealWorld/MarkAllNotificationsRead"
+	RealWorld_CreateLiveTicket_FullMethodName         = "/realworld.v1.RealWorld/CreateLiveTicket"
+	RealWorld_Subscribe_FullMethodName                = "/realworld.v1.RealWorld/Subscribe"
+	RealWorld_GetPresence_FullMethodName              = "/realworld.v1.RealWorld/GetPresence"
+	RealWorld_UpdatePresenceSettings_FullMethodName   = "/realworld.v1.RealWorld/UpdatePresenceSettings"
+	RealWorld_CreateWebhook_FullMethodName            = "/realworld.v1.RealWorld/CreateWebhook"
+	RealWorld_ListWebhooks_FullMethodName             = "/realworld.v1.RealWorld/ListWebhooks"
+	RealWorld_DeleteWebhook_FullMethodName            = "/realworld.v1.RealWorld/DeleteWebhook"
+	RealWorld_ListWebhookDeliveries_FullMethodName    = "/realworld.v1.RealWorld/ListWebhookDeliveries"
 )
 
 // RealWorldClient is the client API for RealWorld service.
@@ -53,6 +86,12 @@ type RealWorldClient interface {
 	GetCurrentUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserReply, error)
 	// 更新当前用户（需要认证）
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserReply, error)
+	// 获取用户的粉丝列表
+	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*MultipleProfileReply, error)
+	// 搜索用户，支持用户名前缀补全和模糊匹配（需要认证）
+	SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*MultipleProfileReply, error)
+	// 获取推荐关注的用户（需要认证）
+	ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...grpc.CallOption) (*MultipleProfileReply, error)
 	// 获取用户资料（认证可选）
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	// 关注用户（需要认证）
@@ -61,28 +100,90 @@ type RealWorldClient interface {
 	UnFollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	// 获取文章列表
 	ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error)
+	// 热门文章，按时间衰减后的阅读、收藏和评论加权排序
+	TrendingArticles(ctx context.Context, in *TrendingArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error)
 	// 获取关注用户的文章列表
 	FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error)
+	// 获取我的草稿（需要认证）
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error)
+	// 全文搜索文章
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesReply, error)
 	// 获取单篇文章
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 相关文章：共同标签、同一作者和全文相似度综合排序
+	RelatedArticles(ctx context.Context, in *RelatedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error)
 	// 创建文章
 	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	// 更新文章
 	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 发布文章，unlisted 为 true 时只能通过链接访问
+	PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 定时发布草稿，scheduledAt 为空时取消定时
+	ScheduleArticle(ctx context.Context, in *ScheduleArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 撤回发布，文章变回草稿
+	UnpublishArticle(ctx context.Context, in *ArticleStatusRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 归档文章
+	ArchiveArticle(ctx context.Context, in *ArticleStatusRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 文章修订历史，只有作者可以查看
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*MultipleRevisionReply, error)
+	// 查看某个修订版本的完整内容
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*SingleRevisionReply, error)
+	// 两个修订版本之间按行比较的 unified diff
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiffReply, error)
+	// 把旧版本恢复为一个新的修订版本，和更新文章一样检查 If-Match
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	// 删除文章
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 新增评论
 	AddComments(ctx context.Context, in *AddCommentsRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
 	// 获取评论
 	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*MultipleCommentReply, error)
+	// 修改评论，只有作者能在发表后的一段时间内修改
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
+	// 评论修改前的各个版本（需要版主权限）
+	ListCommentEdits(ctx context.Context, in *ListCommentEditsRequest, opts ...grpc.CallOption) (*MultipleCommentEditReply, error)
 	// 删除评论
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 收藏文章
 	FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	// 取消收藏文章
 	UnFavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// 给文章添加表态，每种表态每人一次
+	AddArticleReaction(ctx context.Context, in *ArticleReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error)
+	// 撤销对文章的表态
+	RemoveArticleReaction(ctx context.Context, in *ArticleReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error)
+	// 给评论添加表态，每种表态每人一次
+	AddCommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error)
+	// 撤销对评论的表态
+	RemoveCommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error)
 	// 获取标签
 	GetTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTagsReply, error)
+	// 当前用户的通知，同类事件合并为一条（需要认证）
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*MultipleNotificationReply, error)
+	// 未读通知数（需要认证）
+	UnreadNotificationCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UnreadCountReply, error)
+	// 把一条通知标为已读（需要认证）
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 把所有通知标为已读（需要认证）
+	MarkAllNotificationsRead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 换取一次性的实时推送票据，EventSource 不能设置请求头时用 /api/live?ticket= 连接（需要认证）
+	CreateLiveTicket(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LiveTicketReply, error)
+	// 订阅实时事件，与 HTTP 的 /api/live 推送相同的内容（需要认证）
+	// 服务端关闭或客户端读得太慢时以 UNAVAILABLE 结束，客户端带上最后收到的事件 id 重新订阅
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LiveEvent], error)
+	// 批量查询用户的在线状态（需要认证）
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*MultiplePresenceReply, error)
+	// 设置是否对其他人隐藏自己的在线状态（需要认证）
+	UpdatePresenceSettings(ctx context.Context, in *UpdatePresenceSettingsRequest, opts ...grpc.CallOption) (*PresenceSettingsReply, error)
+	// 注册 webhook，接收自己文章的发布、修改、删除和新评论事件；版主可以订阅全站事件（需要认证）
+	// 每次投递是一个 JSON POST，X-Webhook-Signature 为 sha256=hex(HMAC-SHA256(secret, X-Webhook-Timestamp + "." + body))
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*WebhookReply, error)
+	// 当前用户注册的 webhook（需要认证）
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MultipleWebhookReply, error)
+	// 删除 webhook，尚未投递的事件一并丢弃（需要认证）
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// webhook 的投递记录，最新的在前（需要认证）
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*MultipleWebhookDeliveryReply, error)
 }
 
 type realWorldClient struct {
@@ -133,6 +234,36 @@ func (c *realWorldClient) UpdateUser(ctx context.Context, in *UpdateUserRequest,
 	return out, nil
 }
 
+func (c *realWorldClient) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*MultipleProfileReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*MultipleProfileReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileReply)
+	err := c.cc.Invoke(ctx, RealWorld_SearchProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...grpc.CallOption) (*MultipleProfileReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProfileReply)
@@ -173,6 +304,16 @@ func (c *realWorldClient) ListArticles(ctx context.Context, in *ListArticlesRequ
 	return out, nil
 }
 
+func (c *realWorldClient) TrendingArticles(ctx context.Context, in *TrendingArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_TrendingArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MultipleArticleReply)
@@ -183,6 +324,26 @@ func (c *realWorldClient) FeedArticles(ctx context.Context, in *FeedArticlesRequ
 	return out, nil
 }
 
+func (c *realWorldClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArticlesReply)
+	err := c.cc.Invoke(ctx, RealWorld_SearchArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SingleArticleReply)
@@ -193,6 +354,16 @@ func (c *realWorldClient) GetArticle(ctx context.Context, in *GetArticleRequest,
 	return out, nil
 }
 
+func (c *realWorldClient) RelatedArticles(ctx context.Context, in *RelatedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_RelatedArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SingleArticleReply)
@@ -213,6 +384,86 @@ func (c *realWorldClient) UpdateArticle(ctx context.Context, in *UpdateArticleRe
 	return out, nil
 }
 
+func (c *realWorldClient) PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_PublishArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ScheduleArticle(ctx context.Context, in *ScheduleArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_ScheduleArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UnpublishArticle(ctx context.Context, in *ArticleStatusRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_UnpublishArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ArchiveArticle(ctx context.Context, in *ArticleStatusRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_ArchiveArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*MultipleRevisionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleRevisionReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*SingleRevisionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleRevisionReply)
+	err := c.cc.Invoke(ctx, RealWorld_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiffReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevisionDiffReply)
+	err := c.cc.Invoke(ctx, RealWorld_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, RealWorld_RestoreRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -243,6 +494,26 @@ func (c *realWorldClient) GetComments(ctx context.Context, in *GetCommentsReques
 	return out, nil
 }
 
+func (c *realWorldClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleCommentReply)
+	err := c.cc.Invoke(ctx, RealWorld_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListCommentEdits(ctx context.Context, in *ListCommentEditsRequest, opts ...grpc.CallOption) (*MultipleCommentEditReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleCommentEditReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListCommentEdits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -273,6 +544,46 @@ func (c *realWorldClient) UnFavoriteArticle(ctx context.Context, in *FavoriteArt
 	return out, nil
 }
 
+func (c *realWorldClient) AddArticleReaction(ctx context.Context, in *ArticleReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsReply)
+	err := c.cc.Invoke(ctx, RealWorld_AddArticleReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) RemoveArticleReaction(ctx context.Context, in *ArticleReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsReply)
+	err := c.cc.Invoke(ctx, RealWorld_RemoveArticleReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) AddCommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsReply)
+	err := c.cc.Invoke(ctx, RealWorld_AddCommentReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) RemoveCommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...grpc.CallOption) (*ReactionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsReply)
+	err := c.cc.Invoke(ctx, RealWorld_RemoveCommentReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) GetTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTagsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTagsReply)
@@ -283,6 +594,135 @@ func (c *realWorldClient) GetTags(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
+func (c *realWorldClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*MultipleNotificationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleNotificationReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UnreadNotificationCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UnreadCountReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnreadCountReply)
+	err := c.cc.Invoke(ctx, RealWorld_UnreadNotificationCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RealWorld_MarkNotificationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) MarkAllNotificationsRead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RealWorld_MarkAllNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) CreateLiveTicket(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LiveTicketReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LiveTicketReply)
+	err := c.cc.Invoke(ctx, RealWorld_CreateLiveTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LiveEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RealWorld_ServiceDesc.Streams[0], RealWorld_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, LiveEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RealWorld_SubscribeClient = grpc.ServerStreamingClient[LiveEvent]
+
+func (c *realWorldClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*MultiplePresenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiplePresenceReply)
+	err := c.cc.Invoke(ctx, RealWorld_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UpdatePresenceSettings(ctx context.Context, in *UpdatePresenceSettingsRequest, opts ...grpc.CallOption) (*PresenceSettingsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresenceSettingsReply)
+	err := c.cc.Invoke(ctx, RealWorld_UpdatePresenceSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*WebhookReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookReply)
+	err := c.cc.Invoke(ctx, RealWorld_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MultipleWebhookReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleWebhookReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RealWorld_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*MultipleWebhookDeliveryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleWebhookDeliveryReply)
+	err := c.cc.Invoke(ctx, RealWorld_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RealWorldServer is the server API for RealWorld service.
 // All implementations must embed UnimplementedRealWorldServer
 // for forward compatibility.
@@ -295,6 +735,12 @@ type RealWorldServer interface {
 	GetCurrentUser(context.Context, *emptypb.Empty) (*UserReply, error)
 	// 更新当前用户（需要认证）
 	UpdateUser(context.Context, *UpdateUserRequest) (*UserReply, error)
+	// 获取用户的粉丝列表
+	ListFollowers(context.Context, *ListFollowersRequest) (*MultipleProfileReply, error)
+	// 搜索用户，支持用户名前缀补全和模糊匹配（需要认证）
+	SearchProfiles(context.Context, *SearchProfilesRequest) (*MultipleProfileReply, error)
+	// 获取推荐关注的用户（需要认证）
+	ListSuggestions(context.Context, *ListSuggestionsRequest) (*MultipleProfileReply, error)
 	// 获取用户资料（认证可选）
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error)
 	// 关注用户（需要认证）
@@ -303,28 +749,90 @@ type RealWorldServer interface {
 	UnFollowUser(context.Context, *FollowUserRequest) (*ProfileReply, error)
 	// 获取文章列表
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleReply, error)
+	// 热门文章，按时间衰减后的阅读、收藏和评论加权排序
+	TrendingArticles(context.Context, *TrendingArticlesRequest) (*MultipleArticleReply, error)
 	// 获取关注用户的文章列表
 	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleReply, error)
+	// 获取我的草稿（需要认证）
+	ListDrafts(context.Context, *ListDraftsRequest) (*MultipleArticleReply, error)
+	// 全文搜索文章
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error)
 	// 获取单篇文章
 	GetArticle(context.Context, *GetArticleRequest) (*SingleArticleReply, error)
+	// 相关文章：共同标签、同一作者和全文相似度综合排序
+	RelatedArticles(context.Context, *RelatedArticlesRequest) (*MultipleArticleReply, error)
 	// 创建文章
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleReply, error)
 	// 更新文章
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleReply, error)
+	// 发布文章，unlisted 为 true 时只能通过链接访问
+	PublishArticle(context.Context, *PublishArticleRequest) (*SingleArticleReply, error)
+	// 定时发布草稿，scheduledAt 为空时取消定时
+	ScheduleArticle(context.Context, *ScheduleArticleRequest) (*SingleArticleReply, error)
+	// 撤回发布，文章变回草稿
+	UnpublishArticle(context.Context, *ArticleStatusRequest) (*SingleArticleReply, error)
+	// 归档文章
+	ArchiveArticle(context.Context, *ArticleStatusRequest) (*SingleArticleReply, error)
+	// 文章修订历史，只有作者可以查看
+	ListRevisions(context.Context, *ListRevisionsRequest) (*MultipleRevisionReply, error)
+	// 查看某个修订版本的完整内容
+	GetRevision(context.Context, *GetRevisionRequest) (*SingleRevisionReply, error)
+	// 两个修订版本之间按行比较的 unified diff
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiffReply, error)
+	// 把旧版本恢复为一个新的修订版本，和更新文章一样检查 If-Match
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*SingleArticleReply, error)
 	// 删除文章
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*emptypb.Empty, error)
 	// 新增评论
 	AddComments(context.Context, *AddCommentsRequest) (*SingleCommentReply, error)
 	// 获取评论
 	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentReply, error)
+	// 修改评论，只有作者能在发表后的一段时间内修改
+	UpdateComment(context.Context, *UpdateCommentRequest) (*SingleCommentReply, error)
+	// 评论修改前的各个版本（需要版主权限）
+	ListCommentEdits(context.Context, *ListCommentEditsRequest) (*MultipleCommentEditReply, error)
 	// 删除评论
 	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
 	// 收藏文章
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error)
 	// 取消收藏文章
 	UnFavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error)
+	// 给文章添加表态，每种表态每人一次
+	AddArticleReaction(context.Context, *ArticleReactionRequest) (*ReactionsReply, error)
+	// 撤销对文章的表态
+	RemoveArticleReaction(context.Context, *ArticleReactionRequest) (*ReactionsReply, error)
+	// 给评论添加表态，每种表态每人一次
+	AddCommentReaction(context.Context, *CommentReactionRequest) (*ReactionsReply, error)
+	// 撤销对评论的表态
+	RemoveCommentReaction(context.Context, *CommentReactionRequest) (*ReactionsReply, error)
 	// 获取标签
 	GetTags(context.Context, *emptypb.Empty) (*ListTagsReply, error)
+	// 当前用户的通知，同类事件合并为一条（需要认证）
+	ListNotifications(context.Context, *ListNotificationsRequest) (*MultipleNotificationReply, error)
+	// 未读通知数（需要认证）
+	UnreadNotificationCount(context.Context, *emptypb.Empty) (*UnreadCountReply, error)
+	// 把一条通知标为已读（需要认证）
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*emptypb.Empty, error)
+	// 把所有通知标为已读（需要认证）
+	MarkAllNotificationsRead(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// 换取一次性的实时推送票据，EventSource 不能设置请求头时用 /api/live?ticket= 连接（需要认证）
+	CreateLiveTicket(context.Context, *emptypb.Empty) (*LiveTicketReply, error)
+	// 订阅实时事件，与 HTTP 的 /api/live 推送相同的内容（需要认证）
+	// 服务端关闭或客户端读得太慢时以 UNAVAILABLE 结束，客户端带上最后收到的事件 id 重新订阅
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[LiveEvent]) error
+	// 批量查询用户的在线状态（需要认证）
+	GetPresence(context.Context, *GetPresenceRequest) (*MultiplePresenceReply, error)
+	// 设置是否对其他人隐藏自己的在线状态（需要认证）
+	UpdatePresenceSettings(context.Context, *UpdatePresenceSettingsRequest) (*PresenceSettingsReply, error)
+	// 注册 webhook，接收自己文章的发布、修改、删除和新评论事件；版主可以订阅全站事件（需要认证）
+	// 每次投递是一个 JSON POST，X-Webhook-Signature 为 sha256=hex(HMAC-SHA256(secret, X-Webhook-Timestamp + "." + body))
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*WebhookReply, error)
+	// 当前用户注册的 webhook（需要认证）
+	ListWebhooks(context.Context, *emptypb.Empty) (*MultipleWebhookReply, error)
+	// 删除 webhook，尚未投递的事件一并丢弃（需要认证）
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// webhook 的投递记录，最新的在前（需要认证）
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*MultipleWebhookDeliveryReply, error)
 	mustEmbedUnimplementedRealWorldServer()
 }
 
@@ -347,6 +855,15 @@ func (UnimplementedRealWorldServer) GetCurrentUser(context.Context, *emptypb.Emp
 func (UnimplementedRealWorldServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedRealWorldServer) ListFollowers(context.Context, *ListFollowersRequest) (*MultipleProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedRealWorldServer) SearchProfiles(context.Context, *SearchProfilesRequest) (*MultipleProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProfiles not implemented")
+}
+func (UnimplementedRealWorldServer) ListSuggestions(context.Context, *ListSuggestionsRequest) (*MultipleProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuggestions not implemented")
+}
 func (UnimplementedRealWorldServer) GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
 }
@@ -359,20 +876,56 @@ func (UnimplementedRealWorldServer) UnFollowUser(context.Context, *FollowUserReq
 func (UnimplementedRealWorldServer) ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
 }
+func (UnimplementedRealWorldServer) TrendingArticles(context.Context, *TrendingArticlesRequest) (*MultipleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrendingArticles not implemented")
+}
 func (UnimplementedRealWorldServer) FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeedArticles not implemented")
 }
+func (UnimplementedRealWorldServer) ListDrafts(context.Context, *ListDraftsRequest) (*MultipleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedRealWorldServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
 func (UnimplementedRealWorldServer) GetArticle(context.Context, *GetArticleRequest) (*SingleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
 }
+func (UnimplementedRealWorldServer) RelatedArticles(context.Context, *RelatedArticlesRequest) (*MultipleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelatedArticles not implemented")
+}
 func (UnimplementedRealWorldServer) CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateArticle not implemented")
 }
 func (UnimplementedRealWorldServer) UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateArticle not implemented")
 }
-func (UnimplementedRealWorldServer) DeleteArticle(context.Context, *DeleteArticleRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
+func (UnimplementedRealWorldServer) PublishArticle(context.Context, *PublishArticleRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishArticle not implemented")
+}
+func (UnimplementedRealWorldServer) ScheduleArticle(context.Context, *ScheduleArticleRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleArticle not implemented")
+}
+func (UnimplementedRealWorldServer) UnpublishArticle(context.Context, *ArticleStatusRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishArticle not implemented")
+}
+func (UnimplementedRealWorldServer) ArchiveArticle(context.Context, *ArticleStatusRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveArticle not implemented")
+}
+func (UnimplementedRealWorldServer) ListRevisions(context.Context, *ListRevisionsRequest) (*MultipleRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedRealWorldServer) GetRevision(context.Context, *GetRevisionRequest) (*SingleRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedRealWorldServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiffReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedRealWorldServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedRealWorldServer) DeleteArticle(context.Context, *DeleteArticleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
 }
 func (UnimplementedRealWorldServer) AddComments(context.Context, *AddCommentsRequest) (*SingleCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComments not implemented")
@@ -380,6 +933,12 @@ func (UnimplementedRealWorldServer) AddComments(context.Context, *AddCommentsReq
 func (UnimplementedRealWorldServer) GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
+func (UnimplementedRealWorldServer) UpdateComment(context.Context, *UpdateCommentRequest) (*SingleCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedRealWorldServer) ListCommentEdits(context.Context, *ListCommentEditsRequest) (*MultipleCommentEditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentEdits not implemented")
+}
 func (UnimplementedRealWorldServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
@@ -389,368 +948,1003 @@ func (UnimplementedRealWorldServer) FavoriteArticle(context.Context, *FavoriteAr
 func (UnimplementedRealWorldServer) UnFavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnFavoriteArticle not implemented")
 }
-func (UnimplementedRealWorldServer) GetTags(context.Context, *emptypb.Empty) (*ListTagsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+func (UnimplementedRealWorldServer) AddArticleReaction(context.Context, *ArticleReactionRequest) (*ReactionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddArticleReaction not implemented")
+}
+func (UnimplementedRealWorldServer) RemoveArticleReaction(context.Context, *ArticleReactionRequest) (*ReactionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveArticleReaction not implemented")
+}
+func (UnimplementedRealWorldServer) AddCommentReaction(context.Context, *CommentReactionRequest) (*ReactionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommentReaction not implemented")
+}
+func (UnimplementedRealWorldServer) RemoveCommentReaction(context.Context, *CommentReactionRequest) (*ReactionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCommentReaction not implemented")
+}
+func (UnimplementedRealWorldServer) GetTags(context.Context, *emptypb.Empty) (*ListTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedRealWorldServer) ListNotifications(context.Context, *ListNotificationsRequest) (*MultipleNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedRealWorldServer) UnreadNotificationCount(context.Context, *emptypb.Empty) (*UnreadCountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnreadNotificationCount not implemented")
+}
+func (UnimplementedRealWorldServer) MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedRealWorldServer) MarkAllNotificationsRead(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsRead not implemented")
+}
+func (UnimplementedRealWorldServer) CreateLiveTicket(context.Context, *emptypb.Empty) (*LiveTicketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLiveTicket not implemented")
+}
+func (UnimplementedRealWorldServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[LiveEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedRealWorldServer) GetPresence(context.Context, *GetPresenceRequest) (*MultiplePresenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedRealWorldServer) UpdatePresenceSettings(context.Context, *UpdatePresenceSettingsRequest) (*PresenceSettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresenceSettings not implemented")
+}
+func (UnimplementedRealWorldServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*WebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedRealWorldServer) ListWebhooks(context.Context, *emptypb.Empty) (*MultipleWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedRealWorldServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedRealWorldServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*MultipleWebhookDeliveryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedRealWorldServer) mustEmbedUnimplementedRealWorldServer() {}
+func (UnimplementedRealWorldServer) testEmbeddedByValue()                   {}
+
+// UnsafeRealWorldServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RealWorldServer will
+// result in compilation errors.
+type UnsafeRealWorldServer interface {
+	mustEmbedUnimplementedRealWorldServer()
+}
+
+func RegisterRealWorldServer(s grpc.ServiceRegistrar, srv RealWorldServer) {
+	// If the following call pancis, it indicates UnimplementedRealWorldServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RealWorld_ServiceDesc, srv)
+}
+
+func _RealWorld_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).Login(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetCurrentUser(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListFollowers(ctx, req.(*ListFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_SearchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).SearchProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_SearchProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).SearchProfiles(ctx, req.(*SearchProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListSuggestions(ctx, req.(*ListSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnFollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnFollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnFollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnFollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListArticles(ctx, req.(*ListArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_TrendingArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).TrendingArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_TrendingArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).TrendingArticles(ctx, req.(*TrendingArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_FeedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).FeedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_FeedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).FeedArticles(ctx, req.(*FeedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListDrafts(ctx, req.(*ListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetArticle(ctx, req.(*GetArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_RelatedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelatedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).RelatedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_RelatedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).RelatedArticles(ctx, req.(*RelatedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_CreateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).CreateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_CreateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).CreateArticle(ctx, req.(*CreateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UpdateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UpdateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UpdateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UpdateArticle(ctx, req.(*UpdateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_PublishArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).PublishArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_PublishArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).PublishArticle(ctx, req.(*PublishArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ScheduleArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ScheduleArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ScheduleArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ScheduleArticle(ctx, req.(*ScheduleArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnpublishArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnpublishArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnpublishArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnpublishArticle(ctx, req.(*ArticleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ArchiveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ArchiveArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ArchiveArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ArchiveArticle(ctx, req.(*ArticleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).DeleteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_DeleteArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).DeleteArticle(ctx, req.(*DeleteArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_AddComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).AddComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_AddComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).AddComments(ctx, req.(*AddCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetComments(ctx, req.(*GetCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRealWorldServer) mustEmbedUnimplementedRealWorldServer() {}
-func (UnimplementedRealWorldServer) testEmbeddedByValue()                   {}
 
-// UnsafeRealWorldServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RealWorldServer will
-// result in compilation errors.
-type UnsafeRealWorldServer interface {
-	mustEmbedUnimplementedRealWorldServer()
+func _RealWorld_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRealWorldServer(s grpc.ServiceRegistrar, srv RealWorldServer) {
-	// If the following call pancis, it indicates UnimplementedRealWorldServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _RealWorld_ListCommentEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentEditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&RealWorld_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListCommentEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListCommentEdits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListCommentEdits(ctx, req.(*ListCommentEditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _RealWorld_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).Login(ctx, in)
+		return srv.(RealWorldServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_Login_FullMethodName,
+		FullMethod: RealWorld_DeleteComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).Login(ctx, req.(*AuthRequest))
+		return srv.(RealWorldServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _RealWorld_FavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteArticleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).Register(ctx, in)
+		return srv.(RealWorldServer).FavoriteArticle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_Register_FullMethodName,
+		FullMethod: RealWorld_FavoriteArticle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(RealWorldServer).FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _RealWorld_UnFavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteArticleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetCurrentUser(ctx, in)
+		return srv.(RealWorldServer).UnFavoriteArticle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetCurrentUser_FullMethodName,
+		FullMethod: RealWorld_UnFavoriteArticle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetCurrentUser(ctx, req.(*emptypb.Empty))
+		return srv.(RealWorldServer).UnFavoriteArticle(ctx, req.(*FavoriteArticleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _RealWorld_AddArticleReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UpdateUser(ctx, in)
+		return srv.(RealWorldServer).AddArticleReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UpdateUser_FullMethodName,
+		FullMethod: RealWorld_AddArticleReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(RealWorldServer).AddArticleReaction(ctx, req.(*ArticleReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _RealWorld_RemoveArticleReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetProfile(ctx, in)
+		return srv.(RealWorldServer).RemoveArticleReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetProfile_FullMethodName,
+		FullMethod: RealWorld_RemoveArticleReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(RealWorldServer).RemoveArticleReaction(ctx, req.(*ArticleReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _RealWorld_AddCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).FollowUser(ctx, in)
+		return srv.(RealWorldServer).AddCommentReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_FollowUser_FullMethodName,
+		FullMethod: RealWorld_AddCommentReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(RealWorldServer).AddCommentReaction(ctx, req.(*CommentReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UnFollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _RealWorld_RemoveCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UnFollowUser(ctx, in)
+		return srv.(RealWorldServer).RemoveCommentReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UnFollowUser_FullMethodName,
+		FullMethod: RealWorld_RemoveCommentReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UnFollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(RealWorldServer).RemoveCommentReaction(ctx, req.(*CommentReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArticlesRequest)
+func _RealWorld_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).ListArticles(ctx, in)
+		return srv.(RealWorldServer).GetTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_ListArticles_FullMethodName,
+		FullMethod: RealWorld_GetTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).ListArticles(ctx, req.(*ListArticlesRequest))
+		return srv.(RealWorldServer).GetTags(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_FeedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeedArticlesRequest)
+func _RealWorld_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).FeedArticles(ctx, in)
+		return srv.(RealWorldServer).ListNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_FeedArticles_FullMethodName,
+		FullMethod: RealWorld_ListNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).FeedArticles(ctx, req.(*FeedArticlesRequest))
+		return srv.(RealWorldServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArticleRequest)
+func _RealWorld_UnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetArticle(ctx, in)
+		return srv.(RealWorldServer).UnreadNotificationCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetArticle_FullMethodName,
+		FullMethod: RealWorld_UnreadNotificationCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetArticle(ctx, req.(*GetArticleRequest))
+		return srv.(RealWorldServer).UnreadNotificationCount(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_CreateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateArticleRequest)
+func _RealWorld_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).CreateArticle(ctx, in)
+		return srv.(RealWorldServer).MarkNotificationRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_CreateArticle_FullMethodName,
+		FullMethod: RealWorld_MarkNotificationRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).CreateArticle(ctx, req.(*CreateArticleRequest))
+		return srv.(RealWorldServer).MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UpdateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateArticleRequest)
+func _RealWorld_MarkAllNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UpdateArticle(ctx, in)
+		return srv.(RealWorldServer).MarkAllNotificationsRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UpdateArticle_FullMethodName,
+		FullMethod: RealWorld_MarkAllNotificationsRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UpdateArticle(ctx, req.(*UpdateArticleRequest))
+		return srv.(RealWorldServer).MarkAllNotificationsRead(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteArticleRequest)
+func _RealWorld_CreateLiveTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).DeleteArticle(ctx, in)
+		return srv.(RealWorldServer).CreateLiveTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_DeleteArticle_FullMethodName,
+		FullMethod: RealWorld_CreateLiveTicket_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).DeleteArticle(ctx, req.(*DeleteArticleRequest))
+		return srv.(RealWorldServer).CreateLiveTicket(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_AddComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCommentsRequest)
+func _RealWorld_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RealWorldServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, LiveEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RealWorld_SubscribeServer = grpc.ServerStreamingServer[LiveEvent]
+
+func _RealWorld_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).AddComments(ctx, in)
+		return srv.(RealWorldServer).GetPresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_AddComments_FullMethodName,
+		FullMethod: RealWorld_GetPresence_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).AddComments(ctx, req.(*AddCommentsRequest))
+		return srv.(RealWorldServer).GetPresence(ctx, req.(*GetPresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentsRequest)
+func _RealWorld_UpdatePresenceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetComments(ctx, in)
+		return srv.(RealWorldServer).UpdatePresenceSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetComments_FullMethodName,
+		FullMethod: RealWorld_UpdatePresenceSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetComments(ctx, req.(*GetCommentsRequest))
+		return srv.(RealWorldServer).UpdatePresenceSettings(ctx, req.(*UpdatePresenceSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentRequest)
+func _RealWorld_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).DeleteComment(ctx, in)
+		return srv.(RealWorldServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_DeleteComment_FullMethodName,
+		FullMethod: RealWorld_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+		return srv.(RealWorldServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_FavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FavoriteArticleRequest)
+func _RealWorld_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).FavoriteArticle(ctx, in)
+		return srv.(RealWorldServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_FavoriteArticle_FullMethodName,
+		FullMethod: RealWorld_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		return srv.(RealWorldServer).ListWebhooks(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UnFavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FavoriteArticleRequest)
+func _RealWorld_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UnFavoriteArticle(ctx, in)
+		return srv.(RealWorldServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UnFavoriteArticle_FullMethodName,
+		FullMethod: RealWorld_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UnFavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		return srv.(RealWorldServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _RealWorld_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetTags(ctx, in)
+		return srv.(RealWorldServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetTags_FullMethodName,
+		FullMethod: RealWorld_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetTags(ctx, req.(*emptypb.Empty))
+		return srv.(RealWorldServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -778,6 +1972,18 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _RealWorld_UpdateUser_Handler,
 		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _RealWorld_ListFollowers_Handler,
+		},
+		{
+			MethodName: "SearchProfiles",
+			Handler:    _RealWorld_SearchProfiles_Handler,
+		},
+		{
+			MethodName: "ListSuggestions",
+			Handler:    _RealWorld_ListSuggestions_Handler,
+		},
 		{
 			MethodName: "GetProfile",
 			Handler:    _RealWorld_GetProfile_Handler,
@@ -794,14 +2000,30 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListArticles",
 			Handler:    _RealWorld_ListArticles_Handler,
 		},
+		{
+			MethodName: "TrendingArticles",
+			Handler:    _RealWorld_TrendingArticles_Handler,
+		},
 		{
 			MethodName: "FeedArticles",
 			Handler:    _RealWorld_FeedArticles_Handler,
 		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _RealWorld_ListDrafts_Handler,
+		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _RealWorld_SearchArticles_Handler,
+		},
 		{
 			MethodName: "GetArticle",
 			Handler:    _RealWorld_GetArticle_Handler,
 		},
+		{
+			MethodName: "RelatedArticles",
+			Handler:    _RealWorld_RelatedArticles_Handler,
+		},
 		{
 			MethodName: "CreateArticle",
 			Handler:    _RealWorld_CreateArticle_Handler,
@@ -810,6 +2032,38 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateArticle",
 			Handler:    _RealWorld_UpdateArticle_Handler,
 		},
+		{
+			MethodName: "PublishArticle",
+			Handler:    _RealWorld_PublishArticle_Handler,
+		},
+		{
+			MethodName: "ScheduleArticle",
+			Handler:    _RealWorld_ScheduleArticle_Handler,
+		},
+		{
+			MethodName: "UnpublishArticle",
+			Handler:    _RealWorld_UnpublishArticle_Handler,
+		},
+		{
+			MethodName: "ArchiveArticle",
+			Handler:    _RealWorld_ArchiveArticle_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _RealWorld_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _RealWorld_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _RealWorld_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _RealWorld_RestoreRevision_Handler,
+		},
 		{
 			MethodName: "DeleteArticle",
 			Handler:    _RealWorld_DeleteArticle_Handler,
@@ -822,6 +2076,14 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetComments",
 			Handler:    _RealWorld_GetComments_Handler,
 		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _RealWorld_UpdateComment_Handler,
+		},
+		{
+			MethodName: "ListCommentEdits",
+			Handler:    _RealWorld_ListCommentEdits_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _RealWorld_DeleteComment_Handler,
@@ -834,11 +2096,77 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnFavoriteArticle",
 			Handler:    _RealWorld_UnFavoriteArticle_Handler,
 		},
+		{
+			MethodName: "AddArticleReaction",
+			Handler:    _RealWorld_AddArticleReaction_Handler,
+		},
+		{
+			MethodName: "RemoveArticleReaction",
+			Handler:    _RealWorld_RemoveArticleReaction_Handler,
+		},
+		{
+			MethodName: "AddCommentReaction",
+			Handler:    _RealWorld_AddCommentReaction_Handler,
+		},
+		{
+			MethodName: "RemoveCommentReaction",
+			Handler:    _RealWorld_RemoveCommentReaction_Handler,
+		},
 		{
 			MethodName: "GetTags",
 			Handler:    _RealWorld_GetTags_Handler,
 		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _RealWorld_ListNotifications_Handler,
+		},
+		{
+			MethodName: "UnreadNotificationCount",
+			Handler:    _RealWorld_UnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _RealWorld_MarkNotificationRead_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsRead",
+			Handler:    _RealWorld_MarkAllNotificationsRead_Handler,
+		},
+		{
+			MethodName: "CreateLiveTicket",
+			Handler:    _RealWorld_CreateLiveTicket_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _RealWorld_GetPresence_Handler,
+		},
+		{
+			MethodName: "UpdatePresenceSettings",
+			Handler:    _RealWorld_UpdatePresenceSettings_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _RealWorld_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _RealWorld_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _RealWorld_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _RealWorld_ListWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _RealWorld_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "realworld/v1/realworld.proto",
 }
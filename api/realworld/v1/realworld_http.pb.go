@@ -20,35 +20,81 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationRealWorldAddArticleReaction = "/realworld.v1.RealWorld/AddArticleReaction"
+const OperationRealWorldAddCommentReaction = "/realworld.v1.RealWorld/AddCommentReaction"
 const OperationRealWorldAddComments = "/realworld.v1.RealWorld/AddComments"
+const OperationRealWorldArchiveArticle = "/realworld.v1.RealWorld/ArchiveArticle"
 const OperationRealWorldCreateArticle = "/realworld.v1.RealWorld/CreateArticle"
+const OperationRealWorldCreateLiveTicket = "/realworld.v1.RealWorld/CreateLiveTicket"
+const OperationRealWorldCreateWebhook = "/realworld.v1.RealWorld/CreateWebhook"
 const OperationRealWorldDeleteArticle = "/realworld.v1.RealWorld/DeleteArticle"
 const OperationRealWorldDeleteComment = "/realworld.v1.RealWorld/DeleteComment"
+const OperationRealWorldDeleteWebhook = "/realworld.v1.RealWorld/DeleteWebhook"
+const OperationRealWorldDiffRevisions = "/realworld.v1.RealWorld/DiffRevisions"
 const OperationRealWorldFavoriteArticle = "/realworld.v1.RealWorld/FavoriteArticle"
 const OperationRealWorldFeedArticles = "/realworld.v1.RealWorld/FeedArticles"
 const OperationRealWorldFollowUser = "/realworld.v1.RealWorld/FollowUser"
 const OperationRealWorldGetArticle = "/realworld.v1.RealWorld/GetArticle"
 const OperationRealWorldGetComments = "/realworld.v1.RealWorld/GetComments"
 const OperationRealWorldGetCurrentUser = "/realworld.v1.RealWorld/GetCurrentUser"
+const OperationRealWorldGetPresence = "/realworld.v1.RealWorld/GetPresence"
 const OperationRealWorldGetProfile = "/realworld.v1.RealWorld/GetProfile"
+const OperationRealWorldGetRevision = "/realworld.v1.RealWorld/GetRevision"
 const OperationRealWorldGetTags = "/realworld.v1.RealWorld/GetTags"
 const OperationRealWorldListArticles = "/realworld.v1.RealWorld/ListArticles"
+const OperationRealWorldListCommentEdits = "/realworld.v1.RealWorld/ListCommentEdits"
+const OperationRealWorldListDrafts = "/realworld.v1.RealWorld/ListDrafts"
+const OperationRealWorldListFollowers = "/realworld.v1.RealWorld/ListFollowers"
+const OperationRealWorldListNotifications = "/realworld.v1.RealWorld/ListNotifications"
+const OperationRealWorldListRevisions = "/realworld.v1.RealWorld/ListRevisions"
+const OperationRealWorldListSuggestions = "/realworld.v1.RealWorld/ListSuggestions"
+const OperationRealWorldListWebhookDeliveries = "/realworld.v1.RealWorld/ListWebhookDeliveries"
+const OperationRealWorldListWebhooks = "/realworld.v1.RealWorld/ListWebhooks"
 const OperationRealWorldLogin = "/realworld.v1.RealWorld/Login"
+const OperationRealWorldMarkAllNotificationsRead = "/realworld.v1.RealWorld/MarkAllNotificationsRead"
+const OperationRealWorldMarkNotificationRead = "/realworld.v1.RealWorld/MarkNotificationRead"
+const OperationRealWorldPublishArticle = "/realworld.v1.RealWorld/PublishArticle"
 const OperationRealWorldRegister = "/realworld.v1.RealWorld/Register"
+const OperationRealWorldRelatedArticles = "/realworld.v1.RealWorld/RelatedArticles"
+const OperationRealWorldRemoveArticleReaction = "/realworld.v1.RealWorld/RemoveArticleReaction"
+const OperationRealWorldRemoveCommentReaction = "/realworld.v1.RealWorld/RemoveCommentReaction"
+const OperationRealWorldRestoreRevision = "/realworld.v1.RealWorld/RestoreRevision"
+const OperationRealWorldScheduleArticle = "/realworld.v1.RealWorld/ScheduleArticle"
+const OperationRealWorldSearchArticles = "/realworld.v1.RealWorld/SearchArticles"
+const OperationRealWorldSearchProfiles = "/realworld.v1.RealWorld/SearchProfiles"
+const OperationRealWorldTrendingArticles = "/realworld.v1.RealWorld/TrendingArticles"
 const OperationRealWorldUnFavoriteArticle = "/realworld.v1.RealWorld/UnFavoriteArticle"
 const OperationRealWorldUnFollowUser = "/realworld.v1.RealWorld/UnFollowUser"
+const OperationRealWorldUnpublishArticle = "/realworld.v1.RealWorld/UnpublishArticle"
+const OperationRealWorldUnreadNotificationCount = "/realworld.v1.RealWorld/UnreadNotificationCount"
 const OperationRealWorldUpdateArticle = "/realworld.v1.RealWorld/UpdateArticle"
+const OperationRealWorldUpdateComment = "/realworld.v1.RealWorld/UpdateComment"
+const OperationRealWorldUpdatePresenceSettings = "/realworld.v1.RealWorld/UpdatePresenceSettings"
 const OperationRealWorldUpdateUser = "/realworld.v1.RealWorld/UpdateUser"
 
 type RealWorldHTTPServer interface {
+	// AddArticleReaction 给文章添加表态，每种表态每人一次
+	AddArticleReaction(context.Context, *ArticleReactionRequest) (*ReactionsReply, error)
+	// AddCommentReaction 给评论添加表态，每种表态每人一次
+	AddCommentReaction(context.Context, *CommentReactionRequest) (*ReactionsReply, error)
 	// AddComments 新增评论
 	AddComments(context.Context, *AddCommentsRequest) (*SingleCommentReply, error)
+	// ArchiveArticle 归档文章
+	ArchiveArticle(context.Context, *ArticleStatusRequest) (*SingleArticleReply, error)
 	// CreateArticle 创建文章
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleReply, error)
+	// CreateLiveTicket 换取一次性的实时推送票据，EventSource 不能设置请求头时用 /api/live?ticket= 连接（需要认证）
+	CreateLiveTicket(context.Context, *emptypb.Empty) (*LiveTicketReply, error)
+	// CreateWebhook 注册 webhook，接收自己文章的发布、修改、删除和新评论事件；版主可以订阅全站事件（需要认证）
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*WebhookReply, error)
 	// DeleteArticle 删除文章
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*emptypb.Empty, error)
 	// DeleteComment 删除评论
 	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	// DeleteWebhook 删除 webhook，尚未投递的事件一并丢弃（需要认证）
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// DiffRevisions 两个修订版本之间按行比较的 unified diff
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiffReply, error)
 	// FavoriteArticle 收藏文章
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error)
 	// FeedArticles 获取关注用户的文章列表
@@ -61,22 +107,72 @@ type RealWorldHTTPServer interface {
 	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentReply, error)
 	// GetCurrentUser 获取当前用户（需要认证）
 	GetCurrentUser(context.Context, *emptypb.Empty) (*UserReply, error)
+	// GetPresence 批量查询用户的在线状态（需要认证）
+	GetPresence(context.Context, *GetPresenceRequest) (*MultiplePresenceReply, error)
 	// GetProfile 获取用户资料（认证可选）
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error)
+	// GetRevision 查看某个修订版本的完整内容
+	GetRevision(context.Context, *GetRevisionRequest) (*SingleRevisionReply, error)
 	// GetTags 获取标签
 	GetTags(context.Context, *emptypb.Empty) (*ListTagsReply, error)
 	// ListArticles 获取文章列表
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleReply, error)
+	// ListCommentEdits 评论修改前的各个版本（需要版主权限）
+	ListCommentEdits(context.Context, *ListCommentEditsRequest) (*MultipleCommentEditReply, error)
+	// ListDrafts 获取我的草稿（需要认证）
+	ListDrafts(context.Context, *ListDraftsRequest) (*MultipleArticleReply, error)
+	// ListFollowers 获取用户的粉丝列表
+	ListFollowers(context.Context, *ListFollowersRequest) (*MultipleProfileReply, error)
+	// ListNotifications 当前用户的通知，同类事件合并为一条（需要认证）
+	ListNotifications(context.Context, *ListNotificationsRequest) (*MultipleNotificationReply, error)
+	// ListRevisions 文章修订历史，只有作者可以查看
+	ListRevisions(context.Context, *ListRevisionsRequest) (*MultipleRevisionReply, error)
+	// ListSuggestions 获取推荐关注的用户（需要认证）
+	ListSuggestions(context.Context, *ListSuggestionsRequest) (*MultipleProfileReply, error)
+	// ListWebhookDeliveries webhook 的投递记录，最新的在前（需要认证）
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*MultipleWebhookDeliveryReply, error)
+	// ListWebhooks 当前用户注册的 webhook（需要认证）
+	ListWebhooks(context.Context, *emptypb.Empty) (*MultipleWebhookReply, error)
 	// Login 用户登录
 	Login(context.Context, *AuthRequest) (*UserReply, error)
+	// MarkAllNotificationsRead 把所有通知标为已读（需要认证）
+	MarkAllNotificationsRead(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// MarkNotificationRead 把一条通知标为已读（需要认证）
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*emptypb.Empty, error)
+	// PublishArticle 发布文章，unlisted 为 true 时只能通过链接访问
+	PublishArticle(context.Context, *PublishArticleRequest) (*SingleArticleReply, error)
 	// Register 用户注册
 	Register(context.Context, *RegisterRequest) (*UserReply, error)
+	// RelatedArticles 相关文章：共同标签、同一作者和全文相似度综合排序
+	RelatedArticles(context.Context, *RelatedArticlesRequest) (*MultipleArticleReply, error)
+	// RemoveArticleReaction 撤销对文章的表态
+	RemoveArticleReaction(context.Context, *ArticleReactionRequest) (*ReactionsReply, error)
+	// RemoveCommentReaction 撤销对评论的表态
+	RemoveCommentReaction(context.Context, *CommentReactionRequest) (*ReactionsReply, error)
+	// RestoreRevision 把旧版本恢复为一个新的修订版本，和更新文章一样检查 If-Match
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*SingleArticleReply, error)
+	// ScheduleArticle 定时发布草稿，scheduledAt 为空时取消定时
+	ScheduleArticle(context.Context, *ScheduleArticleRequest) (*SingleArticleReply, error)
+	// SearchArticles 全文搜索文章
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error)
+	// SearchProfiles 搜索用户，支持用户名前缀补全和模糊匹配（需要认证）
+	SearchProfiles(context.Context, *SearchProfilesRequest) (*MultipleProfileReply, error)
+	// TrendingArticles 热门文章，按时间衰减后的阅读、收藏和评论加权排序
+	TrendingArticles(context.Context, *TrendingArticlesRequest) (*MultipleArticleReply, error)
 	// UnFavoriteArticle 取消收藏文章
 	UnFavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error)
 	// UnFollowUser 取消关注（需要认证）
 	UnFollowUser(context.Context, *FollowUserRequest) (*ProfileReply, error)
+	// UnpublishArticle 撤回发布，文章变回草稿
+	UnpublishArticle(context.Context, *ArticleStatusRequest) (*SingleArticleReply, error)
+	// UnreadNotificationCount 未读通知数（需要认证）
+	UnreadNotificationCount(context.Context, *emptypb.Empty) (*UnreadCountReply, error)
 	// UpdateArticle 更新文章
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleReply, error)
+	// UpdateComment 修改评论，只有作者能在发表后的一段时间内修改
+	UpdateComment(context.Context, *UpdateCommentRequest) (*SingleCommentReply, error)
+	// UpdatePresenceSettings 设置是否对其他人隐藏自己的在线状态（需要认证）
+	UpdatePresenceSettings(context.Context, *UpdatePresenceSettingsRequest) (*PresenceSettingsReply, error)
 	// UpdateUser 更新当前用户（需要认证）
 	UpdateUser(context.Context, *UpdateUserRequest) (*UserReply, error)
 }
@@ -87,21 +183,53 @@ func RegisterRealWorldHTTPServer(s *http.Server, srv RealWorldHTTPServer) {
 	r.POST("/api/users", _RealWorld_Register0_HTTP_Handler(srv))
 	r.GET("/api/user", _RealWorld_GetCurrentUser0_HTTP_Handler(srv))
 	r.PUT("/api/user", _RealWorld_UpdateUser0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{username}/followers", _RealWorld_ListFollowers0_HTTP_Handler(srv))
+	r.GET("/api/profiles/search", _RealWorld_SearchProfiles0_HTTP_Handler(srv))
+	r.GET("/api/profiles/suggestions", _RealWorld_ListSuggestions0_HTTP_Handler(srv))
 	r.GET("/api/profiles/{username}", _RealWorld_GetProfile0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{username}/follow", _RealWorld_FollowUser0_HTTP_Handler(srv))
 	r.DELETE("/api/profiles/{username}/follow", _RealWorld_UnFollowUser0_HTTP_Handler(srv))
 	r.GET("/api/articles", _RealWorld_ListArticles0_HTTP_Handler(srv))
+	r.GET("/api/articles/trending", _RealWorld_TrendingArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/feed", _RealWorld_FeedArticles0_HTTP_Handler(srv))
+	r.GET("/api/articles/drafts", _RealWorld_ListDrafts0_HTTP_Handler(srv))
+	r.GET("/api/articles/search", _RealWorld_SearchArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/{slug}", _RealWorld_GetArticle0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/related", _RealWorld_RelatedArticles0_HTTP_Handler(srv))
 	r.POST("/api/articles", _RealWorld_CreateArticle0_HTTP_Handler(srv))
 	r.PUT("/api/articles/{slug}", _RealWorld_UpdateArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/publish", _RealWorld_PublishArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/schedule", _RealWorld_ScheduleArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/unpublish", _RealWorld_UnpublishArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/archive", _RealWorld_ArchiveArticle0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/revisions", _RealWorld_ListRevisions0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/revisions/{revision}", _RealWorld_GetRevision0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/revisions/{from}/diff/{to}", _RealWorld_DiffRevisions0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/revisions/{revision}/restore", _RealWorld_RestoreRevision0_HTTP_Handler(srv))
 	r.DELETE("/api/articles/{slug}", _RealWorld_DeleteArticle0_HTTP_Handler(srv))
 	r.POST("/api/articles/{slug}/comments", _RealWorld_AddComments0_HTTP_Handler(srv))
 	r.GET("/api/articles/{slug}/comments", _RealWorld_GetComments0_HTTP_Handler(srv))
+	r.PUT("/api/articles/{slug}/comments/{id}", _RealWorld_UpdateComment0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/comments/{id}/edits", _RealWorld_ListCommentEdits0_HTTP_Handler(srv))
 	r.DELETE("/api/articles/{slug}/comments/{id}", _RealWorld_DeleteComment0_HTTP_Handler(srv))
 	r.POST("/api/articles/{slug}/favorite", _RealWorld_FavoriteArticle0_HTTP_Handler(srv))
 	r.DELETE("/api/articles/{slug}/favorite", _RealWorld_UnFavoriteArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/reactions/{reaction}", _RealWorld_AddArticleReaction0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/reactions/{reaction}", _RealWorld_RemoveArticleReaction0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/comments/{id}/reactions/{reaction}", _RealWorld_AddCommentReaction0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/comments/{id}/reactions/{reaction}", _RealWorld_RemoveCommentReaction0_HTTP_Handler(srv))
 	r.GET("/api/tags", _RealWorld_GetTags0_HTTP_Handler(srv))
+	r.GET("/api/notifications", _RealWorld_ListNotifications0_HTTP_Handler(srv))
+	r.GET("/api/notifications/unread-count", _RealWorld_UnreadNotificationCount0_HTTP_Handler(srv))
+	r.POST("/api/notifications/{id}/read", _RealWorld_MarkNotificationRead0_HTTP_Handler(srv))
+	r.POST("/api/notifications/read", _RealWorld_MarkAllNotificationsRead0_HTTP_Handler(srv))
+	r.POST("/api/live/tickets", _RealWorld_CreateLiveTicket0_HTTP_Handler(srv))
+	r.GET("/api/presence", _RealWorld_GetPresence0_HTTP_Handler(srv))
+	r.PUT("/api/user/presence", _RealWorld_UpdatePresenceSettings0_HTTP_Handler(srv))
+	r.POST("/api/webhooks", _RealWorld_CreateWebhook0_HTTP_Handler(srv))
+	r.GET("/api/webhooks", _RealWorld_ListWebhooks0_HTTP_Handler(srv))
+	r.DELETE("/api/webhooks/{id}", _RealWorld_DeleteWebhook0_HTTP_Handler(srv))
+	r.GET("/api/webhooks/{id}/deliveries", _RealWorld_ListWebhookDeliveries0_HTTP_Handler(srv))
 }
 
 func _RealWorld_Login0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
@@ -189,6 +317,66 @@ func _RealWorld_UpdateUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.
 	}
 }
 
+func _RealWorld_ListFollowers0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListFollowers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowers(ctx, req.(*ListFollowersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_SearchProfiles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchProfilesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldSearchProfiles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchProfiles(ctx, req.(*SearchProfilesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListSuggestions0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSuggestionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListSuggestions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSuggestions(ctx, req.(*ListSuggestionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RealWorld_GetProfile0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetProfileRequest
@@ -274,6 +462,25 @@ func _RealWorld_ListArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx htt
 	}
 }
 
+func _RealWorld_TrendingArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TrendingArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldTrendingArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TrendingArticles(ctx, req.(*TrendingArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RealWorld_FeedArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in FeedArticlesRequest
@@ -293,6 +500,44 @@ func _RealWorld_FeedArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx htt
 	}
 }
 
+func _RealWorld_ListDrafts0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDraftsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListDrafts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDrafts(ctx, req.(*ListDraftsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_SearchArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldSearchArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchArticles(ctx, req.(*SearchArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RealWorld_GetArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetArticleRequest
@@ -315,6 +560,28 @@ func _RealWorld_GetArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.
 	}
 }
 
+func _RealWorld_RelatedArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RelatedArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRelatedArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RelatedArticles(ctx, req.(*RelatedArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RealWorld_CreateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateArticleRequest
@@ -362,31 +629,34 @@ func _RealWorld_UpdateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx ht
 	}
 }
 
-func _RealWorld_DeleteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_PublishArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteArticleRequest
+		var in PublishArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldDeleteArticle)
+		http.SetOperation(ctx, OperationRealWorldPublishArticle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+			return srv.PublishArticle(ctx, req.(*PublishArticleRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*SingleArticleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_AddComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ScheduleArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AddCommentsRequest
+		var in ScheduleArticleRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -396,197 +666,875 @@ func _RealWorld_AddComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldAddComments)
+		http.SetOperation(ctx, OperationRealWorldScheduleArticle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AddComments(ctx, req.(*AddCommentsRequest))
+			return srv.ScheduleArticle(ctx, req.(*ScheduleArticleRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleCommentReply)
+		reply := out.(*SingleArticleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_GetComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_UnpublishArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCommentsRequest
+		var in ArticleStatusRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldGetComments)
+		http.SetOperation(ctx, OperationRealWorldUnpublishArticle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+			return srv.UnpublishArticle(ctx, req.(*ArticleStatusRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleCommentReply)
+		reply := out.(*SingleArticleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_DeleteComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ArchiveArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteCommentRequest
+		var in ArticleStatusRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldDeleteComment)
+		http.SetOperation(ctx, OperationRealWorldArchiveArticle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+			return srv.ArchiveArticle(ctx, req.(*ArticleStatusRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*SingleArticleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_FavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ListRevisions0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FavoriteArticleRequest
+		var in ListRevisionsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldFavoriteArticle)
+		http.SetOperation(ctx, OperationRealWorldListRevisions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+			return srv.ListRevisions(ctx, req.(*ListRevisionsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleReply)
+		reply := out.(*MultipleRevisionReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_UnFavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_GetRevision0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FavoriteArticleRequest
+		var in GetRevisionRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldUnFavoriteArticle)
+		http.SetOperation(ctx, OperationRealWorldGetRevision)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnFavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+			return srv.GetRevision(ctx, req.(*GetRevisionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleReply)
+		reply := out.(*SingleRevisionReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_GetTags0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_DiffRevisions0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in emptypb.Empty
+		var in DiffRevisionsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldGetTags)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDiffRevisions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetTags(ctx, req.(*emptypb.Empty))
+			return srv.DiffRevisions(ctx, req.(*DiffRevisionsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListTagsReply)
+		reply := out.(*RevisionDiffReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type RealWorldHTTPClient interface {
-	// AddComments 新增评论
-	AddComments(ctx context.Context, req *AddCommentsRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
-	// CreateArticle 创建文章
-	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
-	// DeleteArticle 删除文章
-	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// DeleteComment 删除评论
-	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// FavoriteArticle 收藏文章
-	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
-	// FeedArticles 获取关注用户的文章列表
-	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
-	// FollowUser 关注用户（需要认证）
-	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
-	// GetArticle 获取单篇文章
-	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
-	// GetComments 获取评论
-	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentReply, err error)
-	// GetCurrentUser 获取当前用户（需要认证）
-	GetCurrentUser(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UserReply, err error)
-	// GetProfile 获取用户资料（认证可选）
-	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
-	// GetTags 获取标签
-	GetTags(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListTagsReply, err error)
-	// ListArticles 获取文章列表
-	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
-	// Login 用户登录
-	Login(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-	// Register 用户注册
-	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-	// UnFavoriteArticle 取消收藏文章
-	UnFavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
-	// UnFollowUser 取消关注（需要认证）
-	UnFollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
-	// UpdateArticle 更新文章
-	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
-	// UpdateUser 更新当前用户（需要认证）
-	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-}
-
-type RealWorldHTTPClientImpl struct {
-	cc *http.Client
-}
-
-func NewRealWorldHTTPClient(client *http.Client) RealWorldHTTPClient {
-	return &RealWorldHTTPClientImpl{client}
+func _RealWorld_RestoreRevision0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreRevisionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRestoreRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-// AddComments 新增评论
-func (c *RealWorldHTTPClientImpl) AddComments(ctx context.Context, in *AddCommentsRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
-	var out SingleCommentReply
-	pattern := "/api/articles/{slug}/comments"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationRealWorldAddComments))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _RealWorld_DeleteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// CreateArticle 创建文章
-func (c *RealWorldHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
-	var out SingleArticleReply
-	pattern := "/api/articles"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationRealWorldCreateArticle))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+func _RealWorld_AddComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCommentsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddComments(ctx, req.(*AddCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UpdateComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUpdateComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateComment(ctx, req.(*UpdateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListCommentEdits0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCommentEditsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListCommentEdits)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCommentEdits(ctx, req.(*ListCommentEditsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleCommentEditReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_FavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoriteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldFavoriteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UnFavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoriteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUnFavoriteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnFavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_AddArticleReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ArticleReactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddArticleReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddArticleReaction(ctx, req.(*ArticleReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_RemoveArticleReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ArticleReactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRemoveArticleReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveArticleReaction(ctx, req.(*ArticleReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_AddCommentReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CommentReactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddCommentReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddCommentReaction(ctx, req.(*CommentReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_RemoveCommentReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CommentReactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRemoveCommentReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveCommentReaction(ctx, req.(*CommentReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetTags0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTags(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListNotifications0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListNotificationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListNotifications)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNotifications(ctx, req.(*ListNotificationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleNotificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UnreadNotificationCount0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUnreadNotificationCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnreadNotificationCount(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnreadCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_MarkNotificationRead0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MarkNotificationReadRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldMarkNotificationRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_MarkAllNotificationsRead0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldMarkAllNotificationsRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkAllNotificationsRead(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_CreateLiveTicket0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldCreateLiveTicket)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateLiveTicket(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LiveTicketReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetPresence0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPresenceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetPresence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPresence(ctx, req.(*GetPresenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultiplePresenceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UpdatePresenceSettings0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePresenceSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUpdatePresenceSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePresenceSettings(ctx, req.(*UpdatePresenceSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PresenceSettingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_CreateWebhook0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldCreateWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWebhook(ctx, req.(*CreateWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListWebhooks0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListWebhooks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhooks(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteWebhook0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWebhookRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListWebhookDeliveries0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhookDeliveriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListWebhookDeliveries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleWebhookDeliveryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RealWorldHTTPClient interface {
+	// AddArticleReaction 给文章添加表态，每种表态每人一次
+	AddArticleReaction(ctx context.Context, req *ArticleReactionRequest, opts ...http.CallOption) (rsp *ReactionsReply, err error)
+	// AddCommentReaction 给评论添加表态，每种表态每人一次
+	AddCommentReaction(ctx context.Context, req *CommentReactionRequest, opts ...http.CallOption) (rsp *ReactionsReply, err error)
+	// AddComments 新增评论
+	AddComments(ctx context.Context, req *AddCommentsRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	// ArchiveArticle 归档文章
+	ArchiveArticle(ctx context.Context, req *ArticleStatusRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// CreateArticle 创建文章
+	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// CreateLiveTicket 换取一次性的实时推送票据，EventSource 不能设置请求头时用 /api/live?ticket= 连接（需要认证）
+	CreateLiveTicket(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *LiveTicketReply, err error)
+	// CreateWebhook 注册 webhook，接收自己文章的发布、修改、删除和新评论事件；版主可以订阅全站事件（需要认证）
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest, opts ...http.CallOption) (rsp *WebhookReply, err error)
+	// DeleteArticle 删除文章
+	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteComment 删除评论
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteWebhook 删除 webhook，尚未投递的事件一并丢弃（需要认证）
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DiffRevisions 两个修订版本之间按行比较的 unified diff
+	DiffRevisions(ctx context.Context, req *DiffRevisionsRequest, opts ...http.CallOption) (rsp *RevisionDiffReply, err error)
+	// FavoriteArticle 收藏文章
+	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// FeedArticles 获取关注用户的文章列表
+	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
+	// FollowUser 关注用户（需要认证）
+	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	// GetArticle 获取单篇文章
+	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// GetComments 获取评论
+	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentReply, err error)
+	// GetCurrentUser 获取当前用户（需要认证）
+	GetCurrentUser(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UserReply, err error)
+	// GetPresence 批量查询用户的在线状态（需要认证）
+	GetPresence(ctx context.Context, req *GetPresenceRequest, opts ...http.CallOption) (rsp *MultiplePresenceReply, err error)
+	// GetProfile 获取用户资料（认证可选）
+	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	// GetRevision 查看某个修订版本的完整内容
+	GetRevision(ctx context.Context, req *GetRevisionRequest, opts ...http.CallOption) (rsp *SingleRevisionReply, err error)
+	// GetTags 获取标签
+	GetTags(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListTagsReply, err error)
+	// ListArticles 获取文章列表
+	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
+	// ListCommentEdits 评论修改前的各个版本（需要版主权限）
+	ListCommentEdits(ctx context.Context, req *ListCommentEditsRequest, opts ...http.CallOption) (rsp *MultipleCommentEditReply, err error)
+	// ListDrafts 获取我的草稿（需要认证）
+	ListDrafts(ctx context.Context, req *ListDraftsRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
+	// ListFollowers 获取用户的粉丝列表
+	ListFollowers(ctx context.Context, req *ListFollowersRequest, opts ...http.CallOption) (rsp *MultipleProfileReply, err error)
+	// ListNotifications 当前用户的通知，同类事件合并为一条（需要认证）
+	ListNotifications(ctx context.Context, req *ListNotificationsRequest, opts ...http.CallOption) (rsp *MultipleNotificationReply, err error)
+	// ListRevisions 文章修订历史，只有作者可以查看
+	ListRevisions(ctx context.Context, req *ListRevisionsRequest, opts ...http.CallOption) (rsp *MultipleRevisionReply, err error)
+	// ListSuggestions 获取推荐关注的用户（需要认证）
+	ListSuggestions(ctx context.Context, req *ListSuggestionsRequest, opts ...http.CallOption) (rsp *MultipleProfileReply, err error)
+	// ListWebhookDeliveries webhook 的投递记录，最新的在前（需要认证）
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest, opts ...http.CallOption) (rsp *MultipleWebhookDeliveryReply, err error)
+	// ListWebhooks 当前用户注册的 webhook（需要认证）
+	ListWebhooks(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *MultipleWebhookReply, err error)
+	// Login 用户登录
+	Login(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	// MarkAllNotificationsRead 把所有通知标为已读（需要认证）
+	MarkAllNotificationsRead(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// MarkNotificationRead 把一条通知标为已读（需要认证）
+	MarkNotificationRead(ctx context.Context, req *MarkNotificationReadRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// PublishArticle 发布文章，unlisted 为 true 时只能通过链接访问
+	PublishArticle(ctx context.Context, req *PublishArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// Register 用户注册
+	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	// RelatedArticles 相关文章：共同标签、同一作者和全文相似度综合排序
+	RelatedArticles(ctx context.Context, req *RelatedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
+	// RemoveArticleReaction 撤销对文章的表态
+	RemoveArticleReaction(ctx context.Context, req *ArticleReactionRequest, opts ...http.CallOption) (rsp *ReactionsReply, err error)
+	// RemoveCommentReaction 撤销对评论的表态
+	RemoveCommentReaction(ctx context.Context, req *CommentReactionRequest, opts ...http.CallOption) (rsp *ReactionsReply, err error)
+	// RestoreRevision 把旧版本恢复为一个新的修订版本，和更新文章一样检查 If-Match
+	RestoreRevision(ctx context.Context, req *RestoreRevisionRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// ScheduleArticle 定时发布草稿，scheduledAt 为空时取消定时
+	ScheduleArticle(ctx context.Context, req *ScheduleArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// SearchArticles 全文搜索文章
+	SearchArticles(ctx context.Context, req *SearchArticlesRequest, opts ...http.CallOption) (rsp *SearchArticlesReply, err error)
+	// SearchProfiles 搜索用户，支持用户名前缀补全和模糊匹配（需要认证）
+	SearchProfiles(ctx context.Context, req *SearchProfilesRequest, opts ...http.CallOption) (rsp *MultipleProfileReply, err error)
+	// TrendingArticles 热门文章，按时间衰减后的阅读、收藏和评论加权排序
+	TrendingArticles(ctx context.Context, req *TrendingArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleReply, err error)
+	// UnFavoriteArticle 取消收藏文章
+	UnFavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// UnFollowUser 取消关注（需要认证）
+	UnFollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	// UnpublishArticle 撤回发布，文章变回草稿
+	UnpublishArticle(ctx context.Context, req *ArticleStatusRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// UnreadNotificationCount 未读通知数（需要认证）
+	UnreadNotificationCount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UnreadCountReply, err error)
+	// UpdateArticle 更新文章
+	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	// UpdateComment 修改评论，只有作者能在发表后的一段时间内修改
+	UpdateComment(ctx context.Context, req *UpdateCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	// UpdatePresenceSettings 设置是否对其他人隐藏自己的在线状态（需要认证）
+	UpdatePresenceSettings(ctx context.Context, req *UpdatePresenceSettingsRequest, opts ...http.CallOption) (rsp *PresenceSettingsReply, err error)
+	// UpdateUser 更新当前用户（需要认证）
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+}
+
+type RealWorldHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRealWorldHTTPClient(client *http.Client) RealWorldHTTPClient {
+	return &RealWorldHTTPClientImpl{client}
+}
+
+// AddArticleReaction 给文章添加表态，每种表态每人一次
+func (c *RealWorldHTTPClientImpl) AddArticleReaction(ctx context.Context, in *ArticleReactionRequest, opts ...http.CallOption) (*ReactionsReply, error) {
+	var out ReactionsReply
+	pattern := "/api/articles/{slug}/reactions/{reaction}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldAddArticleReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddCommentReaction 给评论添加表态，每种表态每人一次
+func (c *RealWorldHTTPClientImpl) AddCommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...http.CallOption) (*ReactionsReply, error) {
+	var out ReactionsReply
+	pattern := "/api/articles/{slug}/comments/{id}/reactions/{reaction}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldAddCommentReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddComments 新增评论
+func (c *RealWorldHTTPClientImpl) AddComments(ctx context.Context, in *AddCommentsRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/{slug}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldAddComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ArchiveArticle 归档文章
+func (c *RealWorldHTTPClientImpl) ArchiveArticle(ctx context.Context, in *ArticleStatusRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/archive"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldArchiveArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateArticle 创建文章
+func (c *RealWorldHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldCreateArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateLiveTicket 换取一次性的实时推送票据，EventSource 不能设置请求头时用 /api/live?ticket= 连接（需要认证）
+func (c *RealWorldHTTPClientImpl) CreateLiveTicket(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*LiveTicketReply, error) {
+	var out LiveTicketReply
+	pattern := "/api/live/tickets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldCreateLiveTicket))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateWebhook 注册 webhook，接收自己文章的发布、修改、删除和新评论事件；版主可以订阅全站事件（需要认证）
+func (c *RealWorldHTTPClientImpl) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...http.CallOption) (*WebhookReply, error) {
+	var out WebhookReply
+	pattern := "/api/webhooks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldCreateWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -621,6 +1569,34 @@ func (c *RealWorldHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteC
 	return &out, nil
 }
 
+// DeleteWebhook 删除 webhook，尚未投递的事件一并丢弃（需要认证）
+func (c *RealWorldHTTPClientImpl) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/webhooks/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDeleteWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiffRevisions 两个修订版本之间按行比较的 unified diff
+func (c *RealWorldHTTPClientImpl) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...http.CallOption) (*RevisionDiffReply, error) {
+	var out RevisionDiffReply
+	pattern := "/api/articles/{slug}/revisions/{from}/diff/{to}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDiffRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // FavoriteArticle 收藏文章
 func (c *RealWorldHTTPClientImpl) FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
 	var out SingleArticleReply
@@ -705,6 +1681,20 @@ func (c *RealWorldHTTPClientImpl) GetCurrentUser(ctx context.Context, in *emptyp
 	return &out, nil
 }
 
+// GetPresence 批量查询用户的在线状态（需要认证）
+func (c *RealWorldHTTPClientImpl) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...http.CallOption) (*MultiplePresenceReply, error) {
+	var out MultiplePresenceReply
+	pattern := "/api/presence"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetPresence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetProfile 获取用户资料（认证可选）
 func (c *RealWorldHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*ProfileReply, error) {
 	var out ProfileReply
@@ -719,6 +1709,20 @@ func (c *RealWorldHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfile
 	return &out, nil
 }
 
+// GetRevision 查看某个修订版本的完整内容
+func (c *RealWorldHTTPClientImpl) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...http.CallOption) (*SingleRevisionReply, error) {
+	var out SingleRevisionReply
+	pattern := "/api/articles/{slug}/revisions/{revision}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetTags 获取标签
 func (c *RealWorldHTTPClientImpl) GetTags(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListTagsReply, error) {
 	var out ListTagsReply
@@ -747,6 +1751,118 @@ func (c *RealWorldHTTPClientImpl) ListArticles(ctx context.Context, in *ListArti
 	return &out, nil
 }
 
+// ListCommentEdits 评论修改前的各个版本（需要版主权限）
+func (c *RealWorldHTTPClientImpl) ListCommentEdits(ctx context.Context, in *ListCommentEditsRequest, opts ...http.CallOption) (*MultipleCommentEditReply, error) {
+	var out MultipleCommentEditReply
+	pattern := "/api/articles/{slug}/comments/{id}/edits"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListCommentEdits))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDrafts 获取我的草稿（需要认证）
+func (c *RealWorldHTTPClientImpl) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...http.CallOption) (*MultipleArticleReply, error) {
+	var out MultipleArticleReply
+	pattern := "/api/articles/drafts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListDrafts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListFollowers 获取用户的粉丝列表
+func (c *RealWorldHTTPClientImpl) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...http.CallOption) (*MultipleProfileReply, error) {
+	var out MultipleProfileReply
+	pattern := "/api/profiles/{username}/followers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListFollowers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListNotifications 当前用户的通知，同类事件合并为一条（需要认证）
+func (c *RealWorldHTTPClientImpl) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...http.CallOption) (*MultipleNotificationReply, error) {
+	var out MultipleNotificationReply
+	pattern := "/api/notifications"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListNotifications))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRevisions 文章修订历史，只有作者可以查看
+func (c *RealWorldHTTPClientImpl) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...http.CallOption) (*MultipleRevisionReply, error) {
+	var out MultipleRevisionReply
+	pattern := "/api/articles/{slug}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSuggestions 获取推荐关注的用户（需要认证）
+func (c *RealWorldHTTPClientImpl) ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...http.CallOption) (*MultipleProfileReply, error) {
+	var out MultipleProfileReply
+	pattern := "/api/profiles/suggestions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListSuggestions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWebhookDeliveries webhook 的投递记录，最新的在前（需要认证）
+func (c *RealWorldHTTPClientImpl) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...http.CallOption) (*MultipleWebhookDeliveryReply, error) {
+	var out MultipleWebhookDeliveryReply
+	pattern := "/api/webhooks/{id}/deliveries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListWebhookDeliveries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWebhooks 当前用户注册的 webhook（需要认证）
+func (c *RealWorldHTTPClientImpl) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*MultipleWebhookReply, error) {
+	var out MultipleWebhookReply
+	pattern := "/api/webhooks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListWebhooks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Login 用户登录
 func (c *RealWorldHTTPClientImpl) Login(ctx context.Context, in *AuthRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
@@ -761,6 +1877,48 @@ func (c *RealWorldHTTPClientImpl) Login(ctx context.Context, in *AuthRequest, op
 	return &out, nil
 }
 
+// MarkAllNotificationsRead 把所有通知标为已读（需要认证）
+func (c *RealWorldHTTPClientImpl) MarkAllNotificationsRead(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/notifications/read"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldMarkAllNotificationsRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MarkNotificationRead 把一条通知标为已读（需要认证）
+func (c *RealWorldHTTPClientImpl) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/notifications/{id}/read"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldMarkNotificationRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PublishArticle 发布文章，unlisted 为 true 时只能通过链接访问
+func (c *RealWorldHTTPClientImpl) PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldPublishArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Register 用户注册
 func (c *RealWorldHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
@@ -775,6 +1933,118 @@ func (c *RealWorldHTTPClientImpl) Register(ctx context.Context, in *RegisterRequ
 	return &out, nil
 }
 
+// RelatedArticles 相关文章：共同标签、同一作者和全文相似度综合排序
+func (c *RealWorldHTTPClientImpl) RelatedArticles(ctx context.Context, in *RelatedArticlesRequest, opts ...http.CallOption) (*MultipleArticleReply, error) {
+	var out MultipleArticleReply
+	pattern := "/api/articles/{slug}/related"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldRelatedArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveArticleReaction 撤销对文章的表态
+func (c *RealWorldHTTPClientImpl) RemoveArticleReaction(ctx context.Context, in *ArticleReactionRequest, opts ...http.CallOption) (*ReactionsReply, error) {
+	var out ReactionsReply
+	pattern := "/api/articles/{slug}/reactions/{reaction}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldRemoveArticleReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveCommentReaction 撤销对评论的表态
+func (c *RealWorldHTTPClientImpl) RemoveCommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...http.CallOption) (*ReactionsReply, error) {
+	var out ReactionsReply
+	pattern := "/api/articles/{slug}/comments/{id}/reactions/{reaction}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldRemoveCommentReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreRevision 把旧版本恢复为一个新的修订版本，和更新文章一样检查 If-Match
+func (c *RealWorldHTTPClientImpl) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/revisions/{revision}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldRestoreRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ScheduleArticle 定时发布草稿，scheduledAt 为空时取消定时
+func (c *RealWorldHTTPClientImpl) ScheduleArticle(ctx context.Context, in *ScheduleArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/schedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldScheduleArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchArticles 全文搜索文章
+func (c *RealWorldHTTPClientImpl) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...http.CallOption) (*SearchArticlesReply, error) {
+	var out SearchArticlesReply
+	pattern := "/api/articles/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldSearchArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchProfiles 搜索用户，支持用户名前缀补全和模糊匹配（需要认证）
+func (c *RealWorldHTTPClientImpl) SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...http.CallOption) (*MultipleProfileReply, error) {
+	var out MultipleProfileReply
+	pattern := "/api/profiles/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldSearchProfiles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TrendingArticles 热门文章，按时间衰减后的阅读、收藏和评论加权排序
+func (c *RealWorldHTTPClientImpl) TrendingArticles(ctx context.Context, in *TrendingArticlesRequest, opts ...http.CallOption) (*MultipleArticleReply, error) {
+	var out MultipleArticleReply
+	pattern := "/api/articles/trending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldTrendingArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UnFavoriteArticle 取消收藏文章
 func (c *RealWorldHTTPClientImpl) UnFavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
 	var out SingleArticleReply
@@ -803,6 +2073,34 @@ func (c *RealWorldHTTPClientImpl) UnFollowUser(ctx context.Context, in *FollowUs
 	return &out, nil
 }
 
+// UnpublishArticle 撤回发布，文章变回草稿
+func (c *RealWorldHTTPClientImpl) UnpublishArticle(ctx context.Context, in *ArticleStatusRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/unpublish"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldUnpublishArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnreadNotificationCount 未读通知数（需要认证）
+func (c *RealWorldHTTPClientImpl) UnreadNotificationCount(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*UnreadCountReply, error) {
+	var out UnreadCountReply
+	pattern := "/api/notifications/unread-count"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldUnreadNotificationCount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateArticle 更新文章
 func (c *RealWorldHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
 	var out SingleArticleReply
@@ -817,6 +2115,34 @@ func (c *RealWorldHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateA
 	return &out, nil
 }
 
+// UpdateComment 修改评论，只有作者能在发表后的一段时间内修改
+func (c *RealWorldHTTPClientImpl) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/{slug}/comments/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldUpdateComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePresenceSettings 设置是否对其他人隐藏自己的在线状态（需要认证）
+func (c *RealWorldHTTPClientImpl) UpdatePresenceSettings(ctx context.Context, in *UpdatePresenceSettingsRequest, opts ...http.CallOption) (*PresenceSettingsReply, error) {
+	var out PresenceSettingsReply
+	pattern := "/api/user/presence"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldUpdatePresenceSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateUser 更新当前用户（需要认证）
 func (c *RealWorldHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
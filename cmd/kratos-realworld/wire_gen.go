@@ -12,6 +12,7 @@ import (
 	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/data"
+	"kratos-realworld/internal/pkg/cursor"
 	"kratos-realworld/internal/pkg/jwt"
 	"kratos-realworld/internal/server"
 	"kratos-realworld/internal/service"
@@ -24,18 +25,50 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, confBiz *conf.Biz, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	realWorldRepo := data.NewRealWorldRepo(dataData, logger)
-	realWorldUsecase := biz.NewRealWorldUsecase(realWorldRepo, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	mentionRepo := data.NewMentionRepo(dataData, logger)
+	liveRepo := data.NewLiveRepo(dataData, logger)
+	webhookRepo := data.NewWebhookRepo(dataData, logger)
+	realWorldUsecase := biz.NewRealWorldUsecase(realWorldRepo, notificationRepo, mentionRepo, liveRepo, webhookRepo, confBiz, logger)
+	suggestionRepo := data.NewSuggestionRepo(dataData, logger)
+	suggestionUsecase := biz.NewSuggestionUsecase(suggestionRepo, confBiz, logger)
+	searchRepo := data.NewSearchRepo(dataData, logger)
+	searchUsecase := biz.NewSearchUsecase(searchRepo, logger)
+	scheduleRepo := data.NewScheduleRepo(dataData, logger)
+	locker := data.NewLocker(dataData, logger)
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, realWorldRepo, locker, liveRepo, webhookRepo, realWorldUsecase, confBiz, logger)
+	revisionRepo := data.NewRevisionRepo(dataData, logger)
+	revisionUsecase := biz.NewRevisionUsecase(revisionRepo, realWorldRepo, realWorldUsecase, logger)
+	markdownRepo := data.NewMarkdownRepo(dataData, logger)
+	markdownUsecase := biz.NewMarkdownUsecase(markdownRepo, logger)
+	viewRepo := data.NewViewRepo(dataData, logger)
+	trendingUsecase := biz.NewTrendingUsecase(viewRepo, confBiz, logger)
+	relatedRepo := data.NewRelatedRepo(dataData, logger)
+	relatedUsecase := biz.NewRelatedUsecase(relatedRepo, realWorldRepo, confBiz, logger)
+	reactionRepo := data.NewReactionRepo(dataData, logger)
+	reactionUsecase := biz.NewReactionUsecase(reactionRepo, realWorldRepo, confBiz, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, logger)
+	liveUsecase := biz.NewLiveUsecase(liveRepo, realWorldRepo, confBiz, logger)
+	presenceRepo := data.NewPresenceRepo(dataData, logger)
+	presenceUsecase := biz.NewPresenceUsecase(presenceRepo, realWorldRepo, liveRepo, confBiz, logger)
+	webhookSender := data.NewWebhookSender(confBiz)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, realWorldRepo, webhookSender, confBiz, logger)
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	eventPublisher := data.NewEventPublisher(confBiz, dataData, logger)
+	outboxUsecase := biz.NewOutboxUsecase(outboxRepo, eventPublisher, locker, confBiz, logger)
 	jwtService := jwt.NewJWTService(auth)
-	realWorldService := service.NewRealWorldService(realWorldUsecase, jwtService)
-	grpcServer := server.NewGRPCServer(confServer, auth, realWorldService, logger)
-	httpServer := server.NewHTTPServer(confServer, auth, realWorldService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	codec := cursor.NewCodec(auth)
+	realWorldService := service.NewRealWorldService(realWorldUsecase, suggestionUsecase, searchUsecase, scheduleUsecase, revisionUsecase, markdownUsecase, trendingUsecase, relatedUsecase, reactionUsecase, notificationUsecase, liveUsecase, presenceUsecase, webhookUsecase, jwtService, codec)
+	grpcServer := server.NewGRPCServer(confServer, auth, realWorldService, presenceUsecase, logger)
+	httpServer := server.NewHTTPServer(confServer, auth, realWorldService, presenceUsecase, logger)
+	jobServer := server.NewJobServer(locker, suggestionUsecase, scheduleUsecase, trendingUsecase, webhookUsecase, outboxUsecase, presenceUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -6,7 +6,7 @@
 -- ================================================
 
 -- ========== 清理旧表（开发环境用） ==========
-DROP TABLE IF EXISTS article_tags, tags, favorites, follows, comments, articles, users CASCADE;
+DROP TABLE IF EXISTS outbox, webhook_deliveries, webhooks, mentions, notification_actors, notifications, comment_reactions, article_reactions, comment_edits, article_view_days, slug_history, article_revisions, blocks, article_tags, tags, favorites, follows, comments, articles, users CASCADE;
 
 -- ========== 创建数据库（如果还没创建） ==========
 -- ⚠️ 如果你是直接执行在指定 db（如 realworld_db）中，可跳过此步
@@ -23,9 +23,18 @@ CREATE TABLE users (
     password_hash   VARCHAR(255) NOT NULL,
     bio             TEXT,
     image           TEXT,
+    suspended_at    TIMESTAMP,
+    is_moderator    BOOLEAN NOT NULL DEFAULT FALSE,  -- 版主可以查看评论的修改历史
+    version         INT NOT NULL DEFAULT 1,  -- 乐观锁版本号，每次修改资料加一
+    last_seen_at    TIMESTAMP,  -- 最后一次带登录态的活动时间
+    hide_presence   BOOLEAN NOT NULL DEFAULT FALSE,  -- 对其他人隐藏在线状态和最后活动时间
     created_at      TIMESTAMP DEFAULT NOW(),
     updated_at      TIMESTAMP DEFAULT NOW()
 );
+-- 用户搜索：pg_trgm 支持用户名前缀补全（ILIKE 'x%'）和用户名/简介的模糊匹配
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX idx_users_username_trgm ON users USING GIN (username gin_trgm_ops);
+CREATE INDEX idx_users_bio_trgm      ON users USING GIN (bio gin_trgm_ops);
 
 -- ================================================
 -- ARTICLES 表 - 文章
@@ -37,10 +46,69 @@ CREATE TABLE articles (
     description     TEXT,
     body            TEXT,
     author_id       INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- 文章状态：draft 草稿 / published 已发布 / unlisted 仅链接可见 / archived 已归档
+    status          VARCHAR(16) NOT NULL DEFAULT 'published'
+                    CHECK (status IN ('draft', 'published', 'unlisted', 'archived')),
+    published_at    TIMESTAMP,
+    scheduled_at    TIMESTAMPTZ,  -- 草稿的定时发布时间；带时区保存，客户端传的偏移量不会丢失
+    version         INT NOT NULL DEFAULT 1,  -- 乐观锁版本号，每次修改加一，GET 时作为 ETag 返回
+    -- 由 Markdown 正文计算，正文变化时更新；中日韩文字按字计数
+    word_count      INT NOT NULL DEFAULT 0,
+    reading_minutes INT NOT NULL DEFAULT 0,
+    toc             JSONB NOT NULL DEFAULT '[]',  -- 标题目录 [{level, text, id}]
+    views_count     BIGINT NOT NULL DEFAULT 0,    -- 独立访客数，由 Redis HyperLogLog 定期落库
     created_at      TIMESTAMP DEFAULT NOW(),
-    updated_at      TIMESTAMP DEFAULT NOW()
+    updated_at      TIMESTAMP DEFAULT NOW(),
+    -- 全文检索：标题 > 摘要 > 正文 加权；生成列随 INSERT/UPDATE 自动同步
+    search_vector   TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(body, '')), 'C')
+    ) STORED
 );
 CREATE INDEX idx_articles_author_id ON articles(author_id);
+CREATE INDEX idx_articles_search_vector ON articles USING GIN (search_vector);
+-- 列表和关注流按 (published_at, id) 做 keyset 分页，只有已发布的文章进列表
+CREATE INDEX idx_articles_published_at_id ON articles(published_at DESC, id DESC) WHERE status = 'published';
+CREATE INDEX idx_articles_author_status ON articles(author_id, status);
+CREATE INDEX idx_articles_scheduled_at ON articles(scheduled_at) WHERE status = 'draft' AND scheduled_at IS NOT NULL;
+
+-- ================================================
+-- ARTICLE_VIEW_DAYS 表 - 文章每日独立访客数，热门排行按天衰减
+-- ================================================
+CREATE TABLE article_view_days (
+    article_id      INT NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    day             DATE NOT NULL,
+    views           INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (article_id, day)
+);
+CREATE INDEX idx_article_view_days_day ON article_view_days(day);
+
+-- ================================================
+-- SLUG_HISTORY 表 - 文章改名前的 slug，旧链接继续指向原文章
+-- ================================================
+CREATE TABLE slug_history (
+    slug            VARCHAR(255) PRIMARY KEY,
+    article_id      INT NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX idx_slug_history_article_id ON slug_history(article_id);
+
+-- ================================================
+-- ARTICLE_REVISIONS 表 - 文章修订历史（创建和每次更新都记一条）
+-- ================================================
+CREATE TABLE article_revisions (
+    id              SERIAL PRIMARY KEY,
+    article_id      INT NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    revision        INT NOT NULL,  -- 文章内从 1 开始递增的版本号
+    title           VARCHAR(255) NOT NULL,
+    description     TEXT,
+    body            TEXT,
+    editor_id       INT REFERENCES users(id) ON DELETE SET NULL,
+    restored_from   INT,           -- 由旧版本恢复时记录来源版本号
+    created_at      TIMESTAMP DEFAULT NOW(),
+    UNIQUE (article_id, revision)
+);
 
 -- ================================================
 -- COMMENTS 表 - 评论
@@ -50,11 +118,29 @@ CREATE TABLE comments (
     body            TEXT NOT NULL,
     author_id       INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
     article_id      INT NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    parent_id       INT REFERENCES comments(id) ON DELETE CASCADE,  -- 回复的评论，顶层评论为空
+    depth           SMALLINT NOT NULL DEFAULT 0,  -- 顶层评论为 0，回复逐层加一
+    deleted_at      TIMESTAMP,  -- 有回复的评论被删除时只清空正文，留下占位
+    edited_at       TIMESTAMP,  -- 最后一次修改正文的时间，为空表示没有修改过
     created_at      TIMESTAMP DEFAULT NOW(),
     updated_at      TIMESTAMP DEFAULT NOW()
 );
 CREATE INDEX idx_comments_article_id ON comments(article_id);
 CREATE INDEX idx_comments_author_id  ON comments(author_id);
+CREATE INDEX idx_comments_article_created_at ON comments(article_id, created_at DESC, id DESC);
+-- 按楼层分页：顶层评论走上面的索引，回复按 parent_id 分页
+CREATE INDEX idx_comments_parent_created_at ON comments(parent_id, created_at DESC, id DESC);
+
+-- ================================================
+-- COMMENT_EDITS 表 - 评论修改前的正文，供版主查看
+-- ================================================
+CREATE TABLE comment_edits (
+    id              SERIAL PRIMARY KEY,
+    comment_id      INT NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    body            TEXT NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW()  -- 被新正文替换的时间
+);
+CREATE INDEX idx_comment_edits_comment_id ON comment_edits(comment_id, id DESC);
 
 -- ================================================
 -- FOLLOWS 表 - 用户关注关系
@@ -67,6 +153,18 @@ CREATE TABLE follows (
 );
 CREATE INDEX idx_follows_follower_id ON follows(follower_id);
 CREATE INDEX idx_follows_followee_id ON follows(followee_id);
+CREATE INDEX idx_follows_followee_created_at ON follows(followee_id, created_at DESC, follower_id DESC);
+
+-- ================================================
+-- BLOCKS 表 - 用户拉黑关系（推荐、提及等功能需排除被拉黑的用户）
+-- ================================================
+CREATE TABLE blocks (
+    blocker_id      INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id      INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id)
+);
+CREATE INDEX idx_blocks_blocked_id ON blocks(blocked_id);
 
 -- ================================================
 -- FAVORITES 表 - 收藏关系（用户 <-> 文章）
@@ -80,6 +178,126 @@ CREATE TABLE favorites (
 CREATE INDEX idx_favorites_user_id    ON favorites(user_id);
 CREATE INDEX idx_favorites_article_id ON favorites(article_id);
 
+-- ================================================
+-- ARTICLE_REACTIONS / COMMENT_REACTIONS 表 - 表态，每人每种表态一次
+-- 计数缓存在 Redis 哈希 reactions:{article|comment}:{id} 中
+-- ================================================
+CREATE TABLE article_reactions (
+    article_id      INT NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    user_id         INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reaction        VARCHAR(32) NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (article_id, user_id, reaction)
+);
+CREATE INDEX idx_article_reactions_user_id ON article_reactions(user_id);
+
+CREATE TABLE comment_reactions (
+    comment_id      INT NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    user_id         INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reaction        VARCHAR(32) NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (comment_id, user_id, reaction)
+);
+CREATE INDEX idx_comment_reactions_user_id ON comment_reactions(user_id);
+
+-- ================================================
+-- MENTIONS 表 - 文章和评论正文中的 @提及
+-- 正文变化时整体重建；与作者有拉黑关系的用户不会被记录
+-- ================================================
+CREATE TABLE mentions (
+    id              SERIAL PRIMARY KEY,
+    article_id      INT NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    comment_id      INT REFERENCES comments(id) ON DELETE CASCADE,  -- 为空表示出现在文章正文中
+    user_id         INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- 被提及的用户
+    start_offset    INT NOT NULL,  -- 在正文中的 UTF-16 下标（和 JavaScript 字符串一致），左闭右开，包含 @
+    end_offset      INT NOT NULL,
+    notified        BOOLEAN NOT NULL DEFAULT FALSE,  -- 是否已经通知过被提及的用户，撤回后再发布不重复通知
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX idx_mentions_article_id ON mentions(article_id) WHERE comment_id IS NULL;
+CREATE INDEX idx_mentions_comment_id ON mentions(comment_id);
+CREATE INDEX idx_mentions_user_id ON mentions(user_id);
+
+-- ================================================
+-- NOTIFICATIONS 表 - 站内通知
+-- 同一接收人、同类型、同一篇文章的未读事件合并为一条，已读后再来的事件另起一条
+-- ================================================
+CREATE TABLE notifications (
+    id              SERIAL PRIMARY KEY,
+    user_id         INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- 接收人
+    kind            VARCHAR(16) NOT NULL,  -- follow / favorite / comment / mention
+    article_id      INT REFERENCES articles(id) ON DELETE CASCADE,  -- follow 通知为空
+    actors_count    INT NOT NULL DEFAULT 0,
+    read_at         TIMESTAMP,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    updated_at      TIMESTAMP DEFAULT NOW()  -- 最后一次合并新事件的时间
+);
+CREATE UNIQUE INDEX uq_notifications_unread ON notifications(user_id, kind, COALESCE(article_id, 0)) WHERE read_at IS NULL;
+CREATE INDEX idx_notifications_user_updated_at ON notifications(user_id, updated_at DESC, id DESC);
+
+-- ================================================
+-- NOTIFICATION_ACTORS 表 - 合并进一条通知的触发人，同一人只算一次
+-- ================================================
+CREATE TABLE notification_actors (
+    notification_id INT NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    actor_id        INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (notification_id, actor_id)
+);
+
+-- ================================================
+-- WEBHOOKS 表 - 外部系统订阅的内容事件
+-- 普通用户只收到自己文章上的事件，版主可以订阅全站
+-- ================================================
+CREATE TABLE webhooks (
+    id              SERIAL PRIMARY KEY,
+    user_id         INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url             TEXT NOT NULL,
+    secret          VARCHAR(128) NOT NULL,  -- 签名密钥
+    events          TEXT NOT NULL DEFAULT '',  -- 逗号分隔的事件类型，为空表示全部
+    is_global       BOOLEAN NOT NULL DEFAULT FALSE,  -- 订阅全站事件
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX idx_webhooks_user_id ON webhooks(user_id);
+CREATE INDEX idx_webhooks_global ON webhooks(id) WHERE is_global;
+
+-- ================================================
+-- WEBHOOK_DELIVERIES 表 - 每个事件对每个 webhook 的一次投递，也是投递日志
+-- 待投递的 id 同时在 Redis 有序集合里按下次尝试时间排队
+-- ================================================
+CREATE TABLE webhook_deliveries (
+    id              SERIAL PRIMARY KEY,
+    webhook_id      INT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event           VARCHAR(32) NOT NULL,
+    payload         TEXT NOT NULL,  -- 投递的 JSON 原文，重试时原样发送保证签名不变
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',  -- pending / delivered / dead
+    attempts        INT NOT NULL DEFAULT 0,
+    response_status INT NOT NULL DEFAULT 0,  -- 最后一次尝试的 HTTP 状态码
+    last_error      TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP,
+    delivered_at    TIMESTAMP,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX idx_webhook_deliveries_webhook_created_at ON webhook_deliveries(webhook_id, created_at DESC, id DESC);
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+
+-- ================================================
+-- OUTBOX 表 - 领域事件，和引起它的修改写在同一个事务里
+-- 后台中继按 id 顺序发布到 Redis Stream，发布后记下时间，过了保留期清理
+-- 聚合可能已经被删除，这里不加外键
+-- ================================================
+CREATE TABLE outbox (
+    id              BIGSERIAL PRIMARY KEY,
+    aggregate_type  VARCHAR(16) NOT NULL,  -- article / user
+    aggregate_id    INT NOT NULL,
+    event_type      VARCHAR(32) NOT NULL,
+    payload         TEXT NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    published_at    TIMESTAMP
+);
+CREATE INDEX idx_outbox_pending ON outbox(id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_published_at ON outbox(published_at) WHERE published_at IS NOT NULL;
+
 -- ================================================
 -- TAGS 表 - 标签
 -- ================================================
@@ -0,0 +1,265 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 文章状态
+const (
+	ArticleStatusDraft     = "draft"     // 草稿，只有作者可见
+	ArticleStatusPublished = "published" // 已发布，出现在列表和 feed 中
+	ArticleStatusUnlisted  = "unlisted"  // 已发布但不进列表，只能通过链接访问
+	ArticleStatusArchived  = "archived"  // 已归档，不进列表，链接仍可访问
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// PageCursor is a keyset position in a list ordered by (created_at, id) descending.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Page selects a slice of a list: after the cursor when it is set, otherwise by offset.
+type Page struct {
+	Limit  int
+	Offset int
+	After  *PageCursor
+}
+
+// normalize 补全默认 limit；使用 cursor 时 offset 无意义
+func (p *Page) normalize() {
+	p.Limit = clampLimit(p.Limit, defaultPageLimit, maxPageLimit)
+	if p.Offset < 0 || p.After != nil {
+		p.Offset = 0
+	}
+}
+
+// cutPage 仓储层多查一条用来判断是否还有下一页，这里截掉多余的一条并生成 next cursor
+func cutPage[T any](items []T, limit int, key func(T) PageCursor) ([]T, *PageCursor) {
+	if len(items) <= limit {
+		return items, nil
+	}
+	items = items[:limit]
+	next := key(items[limit-1])
+	return items, &next
+}
+
+// ArticleView is an article with its author and the viewer's relation to both.
+type ArticleView struct {
+	Article
+	AuthorName     string
+	AuthorBio      string
+	AuthorImage    string
+	Following      bool
+	Favorited      bool
+	FavoritesCount int64
+	TagList        []string `gorm:"-"`
+}
+
+// ArticleFilter filters ListArticles by tag, author username and favoriting username.
+type ArticleFilter struct {
+	Tag       string
+	Author    string
+	Favorited string
+}
+
+// visibleTo 草稿只对作者可见，其他状态都能通过链接访问
+func (a *Article) visibleTo(myid int64) bool {
+	return a.Status != ArticleStatusDraft || a.AuthorID == myid
+}
+
+// ValidCreateStatus reports whether a status may be chosen when creating an article.
+func ValidCreateStatus(status string) bool {
+	switch status {
+	case ArticleStatusDraft, ArticleStatusPublished, ArticleStatusUnlisted:
+		return true
+	}
+	return false
+}
+
+func articleCursor(a *ArticleView) PageCursor {
+	return PageCursor{CreatedAt: a.CreatedAt, ID: a.ID}
+}
+
+// publishedCursor 已发布的列表按发布时间分页
+func publishedCursor(a *ArticleView) PageCursor {
+	if a.PublishedAt == nil {
+		return articleCursor(a)
+	}
+	return PageCursor{CreatedAt: *a.PublishedAt, ID: a.ID}
+}
+
+// ListArticles returns the most recently published articles matching the filter, the total count and the next cursor.
+func (uc *RealWorldUsecase) ListArticles(ctx context.Context, myid int64, f *ArticleFilter, p *Page) ([]*ArticleView, int64, *PageCursor, error) {
+	p.normalize()
+	list, total, err := uc.repo.ListArticles(ctx, myid, f, p)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	list, next := cutPage(list, p.Limit, publishedCursor)
+	return list, total, next, nil
+}
+
+// FeedArticles returns the most recently published articles of the users myid follows.
+func (uc *RealWorldUsecase) FeedArticles(ctx context.Context, myid int64, p *Page) ([]*ArticleView, int64, *PageCursor, error) {
+	p.normalize()
+	list, total, err := uc.repo.FeedArticles(ctx, myid, p)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	list, next := cutPage(list, p.Limit, publishedCursor)
+	return list, total, next, nil
+}
+
+// ListDrafts returns the drafts of myid, most recent first.
+func (uc *RealWorldUsecase) ListDrafts(ctx context.Context, myid int64, p *Page) ([]*ArticleView, int64, *PageCursor, error) {
+	p.normalize()
+	list, total, err := uc.repo.ListDrafts(ctx, myid, p)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	list, next := cutPage(list, p.Limit, articleCursor)
+	return list, total, next, nil
+}
+
+// GetArticle returns an article as seen by myid. Drafts are only visible to their author.
+func (uc *RealWorldUsecase) GetArticle(ctx context.Context, myid int64, slug string) (*ArticleView, error) {
+	art, err := uc.repo.GetArticleView(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !art.visibleTo(myid) {
+		return nil, ErrArticleNotFound
+	}
+	return art, nil
+}
+
+// visibleArticle 按 slug 查找当前用户可见的文章，评论等子资源都要先经过这里
+func (uc *RealWorldUsecase) visibleArticle(ctx context.Context, myid int64, slug string) (*Article, error) {
+	art, err := uc.repo.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !art.visibleTo(myid) {
+		return nil, ErrArticleNotFound
+	}
+	return art, nil
+}
+
+// CanonicalSlug resolves slug, which may be one the article had before its title changed, to its current slug.
+func (uc *RealWorldUsecase) CanonicalSlug(ctx context.Context, myid int64, slug string) (string, error) {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return "", err
+	}
+	return art.Slug, nil
+}
+
+// FavoriteArticle adds an article to the favorites of myid.
+func (uc *RealWorldUsecase) FavoriteArticle(ctx context.Context, myid int64, slug string) error {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.AddFavorite(ctx, myid, art.ID); err != nil {
+		return err
+	}
+	uc.notify(ctx, &NotificationEvent{Kind: NotificationFavorite, RecipientID: art.AuthorID, ActorID: myid, ArticleID: art.ID})
+	return nil
+}
+
+// UnfavoriteArticle removes an article from the favorites of myid.
+func (uc *RealWorldUsecase) UnfavoriteArticle(ctx context.Context, myid int64, slug string) error {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return err
+	}
+	return uc.repo.RemoveFavorite(ctx, myid, art.ID)
+}
+
+// PublishArticle publishes a draft, unlisted or archived article of myid.
+func (uc *RealWorldUsecase) PublishArticle(ctx context.Context, myid int64, slug string, unlisted bool) (*Article, error) {
+	status := ArticleStatusPublished
+	if unlisted {
+		status = ArticleStatusUnlisted
+	}
+	return uc.changeStatus(ctx, myid, slug, status)
+}
+
+// UnpublishArticle turns a published or unlisted article of myid back into a draft.
+func (uc *RealWorldUsecase) UnpublishArticle(ctx context.Context, myid int64, slug string) (*Article, error) {
+	return uc.changeStatus(ctx, myid, slug, ArticleStatusDraft)
+}
+
+// ArchiveArticle archives an article of myid.
+func (uc *RealWorldUsecase) ArchiveArticle(ctx context.Context, myid int64, slug string) (*Article, error) {
+	return uc.changeStatus(ctx, myid, slug, ArticleStatusArchived)
+}
+
+// articleTransitions 允许的状态流转，key 为目标状态
+var articleTransitions = map[string][]string{
+	ArticleStatusPublished: {ArticleStatusDraft, ArticleStatusUnlisted, ArticleStatusArchived},
+	ArticleStatusUnlisted:  {ArticleStatusDraft, ArticleStatusPublished, ArticleStatusArchived},
+	ArticleStatusDraft:     {ArticleStatusPublished, ArticleStatusUnlisted},
+	ArticleStatusArchived:  {ArticleStatusDraft, ArticleStatusPublished, ArticleStatusUnlisted},
+}
+
+func (uc *RealWorldUsecase) changeStatus(ctx context.Context, myid int64, slug string, status string) (*Article, error) {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	if art.AuthorID != myid {
+		return nil, errors.Forbidden("you are not the article's author", "")
+	}
+	if art.Status == status {
+		return art, nil
+	}
+	allowed := false
+	for _, from := range articleTransitions[status] {
+		if from == art.Status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, errors.Conflict("invalid article status transition", art.Status+" -> "+status)
+	}
+	updated, err := uc.repo.SetArticleStatus(ctx, art.ID, status)
+	if err != nil {
+		return nil, err
+	}
+	//草稿里的提及在发布时才通知
+	if art.Status == ArticleStatusDraft {
+		uc.notifyArticleMentions(ctx, updated)
+	}
+	publishFeed(ctx, uc.live, uc.repo, uc.log, updated)
+	emitStatusWebhook(ctx, uc.hooks, uc.repo, uc.log, art.Status, updated)
+	return updated, nil
+}
+
+// DeleteArticle deletes an article of myid together with its comments, favorites and history.
+func (uc *RealWorldUsecase) DeleteArticle(ctx context.Context, myid int64, slug string) error {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return err
+	}
+	if art.AuthorID != myid {
+		return errors.Forbidden("you are not the article's author", "")
+	}
+	if err := uc.repo.DeleteArticle(ctx, art.ID); err != nil {
+		return err
+	}
+	//只有公开发布过的文章外部系统才知道，其他状态的删除不用通知
+	if art.Status == ArticleStatusPublished {
+		emitWebhook(ctx, uc.hooks, uc.repo, uc.log, WebhookArticleDeleted, art, "", nil)
+	}
+	return nil
+}
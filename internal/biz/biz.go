@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewRealWorldUsecase)
+var ProviderSet = wire.NewSet(NewRealWorldUsecase, NewSuggestionUsecase, NewSearchUsecase, NewScheduleUsecase, NewRevisionUsecase, NewMarkdownUsecase, NewTrendingUsecase, NewRelatedUsecase, NewReactionUsecase, NewNotificationUsecase, NewLiveUsecase, NewPresenceUsecase, NewWebhookUsecase, NewOutboxUsecase)
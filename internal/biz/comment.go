@@ -0,0 +1,234 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// 未配置时回复最多嵌套的层数
+	defaultMaxCommentDepth = 5
+	// 未配置时发表后允许修改的时间
+	defaultCommentEditWindow = 15 * time.Minute
+)
+
+// ErrCommentNotFound is comment not found.
+var ErrCommentNotFound = errors.NotFound(v1.ErrorReason_COMMENT_NOT_FOUND.String(), "comment not found")
+
+// ErrCommentEditClosed is returned when a comment is edited after the edit window.
+var ErrCommentEditClosed = errors.Forbidden("comment can no longer be edited", "")
+
+// Comment is a comment on an article.
+type Comment struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement" json:"id"`
+	Body      string `gorm:"type:text;not null" json:"body"`
+	AuthorID  int64  `gorm:"not null" json:"author_id"`
+	ArticleID int64  `gorm:"not null" json:"article_id"`
+	// 回复的评论，顶层评论为空
+	ParentID *int64 `gorm:"index" json:"parent_id"`
+	Depth    int    `gorm:"not null;default:0" json:"depth"`
+	// 有回复的评论被删除后只留下占位
+	DeletedAt *time.Time `json:"deleted_at"`
+	// 最后一次修改正文的时间
+	EditedAt  *time.Time `json:"edited_at"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// CommentView is a comment with its author and whether the viewer follows them.
+type CommentView struct {
+	Comment
+	AuthorName  string
+	AuthorBio   string
+	AuthorImage string
+	Following   bool
+	ReplyCount  int64
+}
+
+// Deleted reports whether the comment is a placeholder kept for its replies.
+func (c *Comment) Deleted() bool {
+	return c.DeletedAt != nil
+}
+
+// Edited reports whether the comment body has been changed since it was posted.
+func (c *Comment) Edited() bool {
+	return c.EditedAt != nil
+}
+
+// CommentEdit is a previous body of an edited comment.
+type CommentEdit struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement"`
+	CommentID int64     `gorm:"not null"`
+	Body      string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (CommentEdit) TableName() string {
+	return "comment_edits"
+}
+
+func commentCursor(c *CommentView) PageCursor {
+	return PageCursor{CreatedAt: c.CreatedAt, ID: c.ID}
+}
+
+// AddComment adds a comment by myid to the article with the given slug, as a reply to parentID when it is not 0.
+func (uc *RealWorldUsecase) AddComment(ctx context.Context, myid int64, slug string, body string, parentID int64) (*CommentView, error) {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	c := &Comment{
+		Body:      body,
+		AuthorID:  myid,
+		ArticleID: art.ID,
+	}
+	if parentID != 0 {
+		parent, err := uc.articleComment(ctx, myid, art.ID, parentID)
+		if err != nil {
+			return nil, err
+		}
+		//不能回复已删除的评论
+		if parent.Deleted() {
+			return nil, ErrCommentNotFound
+		}
+		if parent.Depth >= uc.maxCommentDepth {
+			return nil, errors.BadRequest("comment thread is too deep", "")
+		}
+		c.ParentID = &parent.ID
+		c.Depth = parent.Depth + 1
+	}
+	c, err = uc.repo.CreateComment(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	uc.notify(ctx, &NotificationEvent{Kind: NotificationComment, RecipientID: art.AuthorID, ActorID: myid, ArticleID: art.ID})
+	uc.syncMentions(ctx, myid, art, c.ID, c.Body)
+	view, err := uc.repo.GetComment(ctx, myid, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	publishLive(ctx, uc.live, uc.log, LiveArticleChannel(art.ID), LiveComment, myid, &LiveCommentEvent{
+		ID:          view.ID,
+		ArticleSlug: art.Slug,
+		ParentID:    parentID,
+		Author:      view.AuthorName,
+		Body:        view.Body,
+		CreatedAt:   view.CreatedAt,
+	})
+	if art.Status == ArticleStatusPublished {
+		emitWebhook(ctx, uc.hooks, uc.repo, uc.log, WebhookCommentCreated, art, "", &WebhookComment{
+			ID:        view.ID,
+			ParentID:  parentID,
+			Author:    view.AuthorName,
+			Body:      view.Body,
+			CreatedAt: view.CreatedAt,
+		})
+	}
+	return view, nil
+}
+
+// ListComments returns the newest top-level comments of an article, or the newest replies to parentID
+// when it is not 0, and the next cursor.
+func (uc *RealWorldUsecase) ListComments(ctx context.Context, myid int64, slug string, parentID int64, p *Page) ([]*CommentView, *PageCursor, error) {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parentID != 0 {
+		if _, err := uc.articleComment(ctx, myid, art.ID, parentID); err != nil {
+			return nil, nil, err
+		}
+	}
+	p.normalize()
+	list, err := uc.repo.ListComments(ctx, myid, art.ID, parentID, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, next := cutPage(list, p.Limit, commentCursor)
+	return list, next, nil
+}
+
+// DeleteComment deletes a comment, only its author may do so.
+func (uc *RealWorldUsecase) DeleteComment(ctx context.Context, myid int64, slug string, id int64) error {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return err
+	}
+	c, err := uc.articleComment(ctx, myid, art.ID, id)
+	if err != nil {
+		return err
+	}
+	if c.Deleted() {
+		return ErrCommentNotFound
+	}
+	if c.AuthorID != myid {
+		return errors.Forbidden("you are not the comment's author", "")
+	}
+	return uc.repo.DeleteComment(ctx, id)
+}
+
+// UpdateComment replaces the body of a comment, only its author may do so within the edit window.
+func (uc *RealWorldUsecase) UpdateComment(ctx context.Context, myid int64, slug string, id int64, body string) (*CommentView, error) {
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	c, err := uc.articleComment(ctx, myid, art.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.Deleted() {
+		return nil, ErrCommentNotFound
+	}
+	if c.AuthorID != myid {
+		return nil, errors.Forbidden("you are not the comment's author", "")
+	}
+	//内容没有变化时不产生修改记录
+	if body == c.Body {
+		return c, nil
+	}
+	if err := uc.repo.UpdateComment(ctx, id, body, uc.commentEditWindow); err != nil {
+		return nil, err
+	}
+	uc.syncMentions(ctx, myid, art, id, body)
+	return uc.repo.GetComment(ctx, myid, id)
+}
+
+// ListCommentEdits returns the previous bodies of a comment, newest first. Only moderators may see them.
+func (uc *RealWorldUsecase) ListCommentEdits(ctx context.Context, myid int64, slug string, id int64) ([]*CommentEdit, error) {
+	user, err := uc.repo.FindByID(ctx, myid)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.Moderator {
+		return nil, errors.Forbidden("moderator only", "")
+	}
+	art, err := uc.visibleArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.articleComment(ctx, myid, art.ID, id); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListCommentEdits(ctx, id)
+}
+
+// articleComment 查找文章下的评论，不属于该文章时按不存在处理
+func (uc *RealWorldUsecase) articleComment(ctx context.Context, myid, articleID, id int64) (*CommentView, error) {
+	c, err := uc.repo.GetComment(ctx, myid, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.ArticleID != articleID {
+		return nil, ErrCommentNotFound
+	}
+	return c, nil
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 实时事件类型
+const (
+	LiveComment      = "comment"
+	LiveNotification = "notification"
+	LiveFeed         = "feed"
+	LivePresence     = "presence"
+)
+
+const (
+	// 没有事件时发送心跳的间隔，让代理和客户端知道连接还活着
+	defaultLiveHeartbeat = 15 * time.Second
+	// 推送票据的有效期，客户端拿到后马上连接
+	liveTicketTTL = 30 * time.Second
+)
+
+// ErrLiveTicketInvalid is returned when a live ticket is unknown, expired or already used.
+var ErrLiveTicketInvalid = errors.Unauthorized("live ticket is invalid or expired", "")
+
+// LiveEvent is an event pushed to connected clients. ID orders events within a channel and is used to resume.
+type LiveEvent struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type"`
+	// 触发事件的用户，推送前按拉黑关系过滤，不发给客户端
+	ActorID int64           `json:"actor"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// LiveCommentEvent is a new comment on a watched article.
+type LiveCommentEvent struct {
+	ID          int64     `json:"id"`
+	ArticleSlug string    `json:"articleSlug"`
+	ParentID    int64     `json:"parentId,omitempty"`
+	Author      string    `json:"author"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// LiveNotificationEvent tells a user a notification was added or grouped, the list should be reloaded.
+type LiveNotificationEvent struct {
+	ID   int64  `json:"id"`
+	Kind string `json:"kind"`
+}
+
+// LiveFeedEvent is an article published by someone the user follows.
+type LiveFeedEvent struct {
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+}
+
+// LiveUserChannel is the channel of events for one user: notifications and feed updates.
+func LiveUserChannel(userID int64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// LiveArticleChannel is the channel of new comments on one article.
+func LiveArticleChannel(articleID int64) string {
+	return fmt.Sprintf("article:%d", articleID)
+}
+
+// LiveRepo is a live event repo.
+type LiveRepo interface {
+	// Publish 推送到所有实例上订阅了 channel 的连接，并保留最近的事件供断线重连补发
+	Publish(ctx context.Context, channel string, e *LiveEvent) error
+	// Subscribe 订阅 channels，lastID 不为空时先补发其后的事件；ctx 结束时关闭返回的 channel
+	Subscribe(ctx context.Context, channels []string, lastID string) (<-chan *LiveEvent, error)
+	// FollowerIDs 关注了 userID 的用户
+	FollowerIDs(ctx context.Context, userID int64) ([]int64, error)
+	// Blocked 两人之间任意一方拉黑了另一方
+	Blocked(ctx context.Context, a, b int64) (bool, error)
+	// SaveTicket 保存属于 userID 的推送票据，ttl 后过期
+	SaveTicket(ctx context.Context, ticket string, userID int64, ttl time.Duration) error
+	// TakeTicket 取出并删除票据，返回所属用户；票据不存在时返回 0
+	TakeTicket(ctx context.Context, ticket string) (int64, error)
+}
+
+// publishLive 推送一个事件；失败只记日志，实时推送不影响主流程
+func publishLive(ctx context.Context, live LiveRepo, l *log.Helper, channel, typ string, actorID int64, data interface{}) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		l.WithContext(ctx).Warnf("encode %s event error: %v", typ, err)
+		return
+	}
+	if err := live.Publish(ctx, channel, &LiveEvent{Type: typ, ActorID: actorID, Data: b}); err != nil {
+		l.WithContext(ctx).Warnf("publish %s event error: %v", typ, err)
+	}
+}
+
+// publishFeed 文章公开发布后推给作者的所有关注者
+func publishFeed(ctx context.Context, live LiveRepo, articles RealWorldRepo, l *log.Helper, art *Article) {
+	if art.Status != ArticleStatusPublished {
+		return
+	}
+	author, err := articles.FindByID(ctx, art.AuthorID)
+	if err != nil || author == nil {
+		l.WithContext(ctx).Warnf("find article author error: %v", err)
+		return
+	}
+	ids, err := live.FollowerIDs(ctx, art.AuthorID)
+	if err != nil {
+		l.WithContext(ctx).Warnf("list followers error: %v", err)
+		return
+	}
+	e := &LiveFeedEvent{Slug: art.Slug, Title: art.Title, Author: author.UserName}
+	for _, id := range ids {
+		publishLive(ctx, live, l, LiveUserChannel(id), LiveFeed, art.AuthorID, e)
+	}
+}
+
+// LiveUsecase is a live updates usecase.
+type LiveUsecase struct {
+	repo      LiveRepo
+	articles  RealWorldRepo
+	heartbeat time.Duration
+	log       *log.Helper
+}
+
+// NewLiveUsecase new a live updates usecase.
+func NewLiveUsecase(repo LiveRepo, articles RealWorldRepo, c *conf.Biz, logger log.Logger) *LiveUsecase {
+	uc := &LiveUsecase{
+		repo:      repo,
+		articles:  articles,
+		heartbeat: defaultLiveHeartbeat,
+		log:       log.NewHelper(logger),
+	}
+	if d := c.GetLive().GetHeartbeat(); d != nil && d.AsDuration() > 0 {
+		uc.heartbeat = d.AsDuration()
+	}
+	return uc
+}
+
+// Heartbeat returns how often an idle stream sends a heartbeat.
+func (uc *LiveUsecase) Heartbeat() time.Duration {
+	return uc.heartbeat
+}
+
+// CreateTicket returns a single-use ticket that authenticates one live connection of myid, and how long it
+// is valid. EventSource 不能设置请求头，用票据代替 URL 里长期有效的 token
+func (uc *LiveUsecase) CreateTicket(ctx context.Context, myid int64) (string, time.Duration, error) {
+	ticket, err := randomHex(32)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := uc.repo.SaveTicket(ctx, ticket, myid, liveTicketTTL); err != nil {
+		return "", 0, err
+	}
+	return ticket, liveTicketTTL, nil
+}
+
+// RedeemTicket uses up a ticket and returns the user it was created for.
+func (uc *LiveUsecase) RedeemTicket(ctx context.Context, ticket string) (int64, error) {
+	userID, err := uc.repo.TakeTicket(ctx, ticket)
+	if err != nil {
+		return 0, err
+	}
+	if userID == 0 {
+		return 0, ErrLiveTicketInvalid
+	}
+	return userID, nil
+}
+
+// Subscribe streams the notifications and feed updates of myid, and the new comments on the article with
+// the given slug when it is not empty. Events after lastID are replayed first. The channel is closed when
+// ctx is done.
+func (uc *LiveUsecase) Subscribe(ctx context.Context, myid int64, slug string, lastID string) (<-chan *LiveEvent, error) {
+	channels := []string{LiveUserChannel(myid)}
+	if slug != "" {
+		art, err := uc.articles.GetArticleBySlug(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		if !art.visibleTo(myid) {
+			return nil, ErrArticleNotFound
+		}
+		channels = append(channels, LiveArticleChannel(art.ID))
+	}
+	events, err := uc.repo.Subscribe(ctx, channels, lastID)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *LiveEvent)
+	go func() {
+		defer close(out)
+		// 同一个连接上拉黑关系只查一次
+		blocked := make(map[int64]bool)
+		for e := range events {
+			if e.ActorID != 0 && e.ActorID != myid {
+				b, ok := blocked[e.ActorID]
+				if !ok {
+					var err error
+					if b, err = uc.repo.Blocked(ctx, myid, e.ActorID); err != nil {
+						uc.log.WithContext(ctx).Warnf("check block error: %v", err)
+					}
+					blocked[e.ActorID] = b
+				}
+				if b {
+					continue
+				}
+			}
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				// 继续读完 events，让仓储层的 goroutine 退出
+				for range events {
+				}
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,12 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// Locker is a lock shared by every replica of the service.
+type Locker interface {
+	// TryLock 尝试加锁，成功时返回释放函数；锁已被其他实例持有时 ok 为 false
+	TryLock(ctx context.Context, key string, ttl time.Duration) (unlock func(), ok bool, err error)
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+
+	"kratos-realworld/internal/pkg/markdown"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 渲染结果缓存的内容类型
+const (
+	HTMLKindArticle = "article"
+	HTMLKindComment = "comment"
+)
+
+// HTMLKey identifies one revision of a rendered body.
+type HTMLKey struct {
+	Kind string
+	ID   int64
+	// 内容变化时随之变化：文章用 version，评论用 updated_at
+	Revision int64
+}
+
+// MarkdownRepo caches rendered Markdown.
+type MarkdownRepo interface {
+	// GetHTML 返回值与 keys 一一对应，未命中为空串
+	GetHTML(ctx context.Context, keys []HTMLKey) ([]string, error)
+	SetHTML(ctx context.Context, keys []HTMLKey, html []string) error
+}
+
+// MarkdownUsecase renders article and comment bodies into sanitized HTML.
+type MarkdownUsecase struct {
+	repo MarkdownRepo
+	log  *log.Helper
+}
+
+// NewMarkdownUsecase new a markdown usecase.
+func NewMarkdownUsecase(repo MarkdownRepo, logger log.Logger) *MarkdownUsecase {
+	return &MarkdownUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// ArticleHTML returns the rendered body of an article.
+func (uc *MarkdownUsecase) ArticleHTML(ctx context.Context, a *Article) (string, error) {
+	out, err := uc.render(ctx, []HTMLKey{{Kind: HTMLKindArticle, ID: a.ID, Revision: a.Version}}, []string{a.Body})
+	if err != nil {
+		return "", err
+	}
+	return out[0], nil
+}
+
+// CommentsHTML returns the rendered bodies of comments keyed by comment id.
+func (uc *MarkdownUsecase) CommentsHTML(ctx context.Context, list []*CommentView) (map[int64]string, error) {
+	keys := make([]HTMLKey, 0, len(list))
+	sources := make([]string, 0, len(list))
+	for _, c := range list {
+		keys = append(keys, HTMLKey{Kind: HTMLKindComment, ID: c.ID, Revision: c.UpdatedAt.UnixMicro()})
+		sources = append(sources, c.Body)
+	}
+	out, err := uc.render(ctx, keys, sources)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int64]string, len(list))
+	for i, c := range list {
+		m[c.ID] = out[i]
+	}
+	return m, nil
+}
+
+// render 先批量查缓存，未命中的渲染后写回；缓存不可用时直接渲染，不影响请求
+func (uc *MarkdownUsecase) render(ctx context.Context, keys []HTMLKey, sources []string) ([]string, error) {
+	out, err := uc.repo.GetHTML(ctx, keys)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("get rendered html: %v", err)
+		out = make([]string, len(keys))
+	}
+	var missKeys []HTMLKey
+	var missHTML []string
+	for i, src := range sources {
+		if out[i] != "" || src == "" {
+			continue
+		}
+		html, err := markdown.Render(src)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = html
+		missKeys = append(missKeys, keys[i])
+		missHTML = append(missHTML, html)
+	}
+	if len(missKeys) > 0 {
+		if err := uc.repo.SetHTML(ctx, missKeys, missHTML); err != nil {
+			uc.log.WithContext(ctx).Warnf("cache rendered html: %v", err)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,150 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+)
+
+// 提及出现的位置
+const (
+	MentionSourceArticle = "article"
+	MentionSourceComment = "comment"
+)
+
+// 一篇正文最多解析的不同用户名，避免一次写入触发大量查询和通知
+const maxMentionedUsers = 20
+
+// mentionPattern 用户名由任意语言的字母、数字和下划线组成，中间可以有 . 和 -，不以它们结尾。
+// @ 前不能是 ASCII 字母数字，避免把邮箱地址当成提及；中文句子没有空格，汉字后面的 @ 仍算提及
+var mentionPattern = regexp.MustCompile(`(?:^|[^0-9A-Za-z_@])@([\p{L}\p{N}_](?:[\p{L}\p{N}_.-]*[\p{L}\p{N}_])?)`)
+
+// Mention is an @username in a body. Start and End are UTF-16 code unit offsets, End exclusive, covering the @,
+// so that they index the body the same way JavaScript strings do.
+type Mention struct {
+	// 解析时为 0，解析出的用户名找到对应用户后填入
+	UserID   int64
+	UserName string `gorm:"column:username"`
+	Start    int    `gorm:"column:start_offset"`
+	End      int    `gorm:"column:end_offset"`
+}
+
+// ParseMentions returns the @usernames in body in order of appearance.
+func ParseMentions(body string) []*Mention {
+	var list []*Mention
+	// 逐段累加 UTF-16 长度，每个字符只数一次
+	pos, offset := 0, 0
+	for _, m := range mentionPattern.FindAllStringSubmatchIndex(body, -1) {
+		// m[2:4] 是用户名，前面一个字节是 @
+		at := m[2] - 1
+		offset += utf16Len(body[pos:at])
+		name := body[m[2]:m[3]]
+		list = append(list, &Mention{
+			UserName: name,
+			Start:    offset,
+			End:      offset + 1 + utf16Len(name),
+		})
+		pos = at
+	}
+	return list
+}
+
+// utf16Len 字符串按 UTF-16 编码的长度，BMP 之外的字符（如 emoji）占两个单位
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n++
+		if r > 0xFFFF {
+			n++
+		}
+	}
+	return n
+}
+
+// MentionRepo is a mention repo.
+type MentionRepo interface {
+	// ReplaceMentions 用 list 替换一段正文原有的提及，commentID 为 0 表示文章正文；
+	// 仍被提及的用户保留已通知的标记
+	ReplaceMentions(ctx context.Context, articleID, commentID int64, list []*Mention) error
+	// MarkNotified 把一段正文中还没通知过的提及标记为已通知，返回这些被提及的用户
+	MarkNotified(ctx context.Context, articleID, commentID int64) ([]int64, error)
+	// ListMentions 批量读取文章或评论正文中的提及，key 为文章或评论 id
+	ListMentions(ctx context.Context, source string, ids []int64) (map[int64][]*Mention, error)
+	// Blocked 两人之间任意一方拉黑了另一方
+	Blocked(ctx context.Context, a, b int64) (bool, error)
+}
+
+// resolveMentions 解析正文中的提及并找到对应的用户，找不到的用户名和与作者有拉黑关系的用户都会被忽略
+func (uc *RealWorldUsecase) resolveMentions(ctx context.Context, authorID int64, body string) ([]*Mention, error) {
+	users := make(map[string]int64)
+	var list []*Mention
+	for _, m := range ParseMentions(body) {
+		id, ok := users[m.UserName]
+		if !ok {
+			if len(users) >= maxMentionedUsers {
+				continue
+			}
+			u, err := uc.repo.FindByUserName(ctx, m.UserName)
+			if err != nil {
+				return nil, err
+			}
+			if u != nil {
+				blocked, err := uc.mentions.Blocked(ctx, authorID, u.ID)
+				if err != nil {
+					return nil, err
+				}
+				if !blocked {
+					id = u.ID
+				}
+			}
+			users[m.UserName] = id
+		}
+		if id != 0 {
+			m.UserID = id
+			list = append(list, m)
+		}
+	}
+	return list, nil
+}
+
+// syncMentions 正文写入后重建它的提及，commentID 为 0 表示文章正文；
+// 文章对外可见时通知还没通知过的被提及用户。失败只记日志，不影响已经写入的正文
+func (uc *RealWorldUsecase) syncMentions(ctx context.Context, authorID int64, art *Article, commentID int64, body string) {
+	list, err := uc.resolveMentions(ctx, authorID, body)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("resolve mentions error: %v", err)
+		return
+	}
+	if err := uc.mentions.ReplaceMentions(ctx, art.ID, commentID, list); err != nil {
+		uc.log.WithContext(ctx).Warnf("save mentions error: %v", err)
+		return
+	}
+	if art.Status == ArticleStatusDraft {
+		return
+	}
+	uc.notifyMentions(ctx, authorID, art.ID, commentID)
+}
+
+// notifyArticleMentions 草稿发布时通知文章正文中提及的用户；撤回后再次发布时已经通知过的用户不再通知
+func (uc *RealWorldUsecase) notifyArticleMentions(ctx context.Context, art *Article) {
+	uc.notifyMentions(ctx, art.AuthorID, art.ID, 0)
+}
+
+// notifyMentions 通知一段正文中还没通知过的被提及用户
+func (uc *RealWorldUsecase) notifyMentions(ctx context.Context, authorID, articleID, commentID int64) {
+	ids, err := uc.mentions.MarkNotified(ctx, articleID, commentID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("mark mentions notified error: %v", err)
+		return
+	}
+	for _, id := range ids {
+		uc.notify(ctx, &NotificationEvent{Kind: NotificationMention, RecipientID: id, ActorID: authorID, ArticleID: articleID})
+	}
+}
+
+// Mentions returns the mentions in the bodies of articles or comments keyed by their id.
+func (uc *RealWorldUsecase) Mentions(ctx context.Context, source string, ids []int64) (map[int64][]*Mention, error) {
+	if len(ids) == 0 {
+		return map[int64][]*Mention{}, nil
+	}
+	return uc.mentions.ListMentions(ctx, source, ids)
+}
@@ -0,0 +1,51 @@
+package biz_test
+
+import (
+	"testing"
+	"unicode/utf16"
+
+	"kratos-realworld/internal/biz"
+)
+
+func TestParseMentions(t *testing.T) {
+	type mention struct {
+		name       string
+		start, end int
+	}
+	tests := []struct {
+		name string
+		body string
+		want []mention
+	}{
+		{"start of body", "@alice hi", []mention{{"alice", 0, 6}}},
+		{"several", "hi @alice and @bob.", []mention{{"alice", 3, 9}, {"bob", 14, 18}}},
+		{"dots and dashes inside", "cc @jane.doe-2.", []mention{{"jane.doe-2", 3, 14}}},
+		{"email is not a mention", "mail alice@example.com", nil},
+		{"double at", "@@alice", nil},
+		{"unicode name", "谢谢@张三！", []mention{{"张三", 2, 5}}},
+		{"accented name", "merci @José", []mention{{"José", 6, 11}}},
+		{"emoji before counts two units", "🎉 @alice", []mention{{"alice", 3, 9}}},
+		{"emoji between", "@a 🎉🎉 @b", []mention{{"a", 0, 2}, {"b", 8, 10}}},
+		{"bare at", "@ alone", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := biz.ParseMentions(tt.body)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseMentions(%q) returned %d mentions, want %d", tt.body, len(got), len(tt.want))
+			}
+			units := utf16.Encode([]rune(tt.body))
+			for i, w := range tt.want {
+				m := got[i]
+				if m.UserName != w.name || m.Start != w.start || m.End != w.end {
+					t.Errorf("mention %d = {%q %d %d}, want {%q %d %d}", i, m.UserName, m.Start, m.End, w.name, w.start, w.end)
+					continue
+				}
+				// 偏移量按 UTF-16 截出来的正是 @用户名
+				if s := string(utf16.Decode(units[m.Start:m.End])); s != "@"+w.name {
+					t.Errorf("body[%d:%d] = %q, want %q", m.Start, m.End, s, "@"+w.name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 通知类型
+const (
+	NotificationFollow   = "follow"
+	NotificationFavorite = "favorite"
+	NotificationComment  = "comment"
+	NotificationMention  = "mention"
+)
+
+// ErrNotificationNotFound is notification not found.
+var ErrNotificationNotFound = errors.NotFound(v1.ErrorReason_NOTIFICATION_NOT_FOUND.String(), "notification not found")
+
+// NotificationEvent is something actorID did that recipientID should hear about.
+type NotificationEvent struct {
+	Kind        string
+	RecipientID int64
+	ActorID     int64
+	// follow 事件为 0
+	ArticleID int64
+}
+
+// NotificationActor is one of the users grouped into a notification.
+type NotificationActor struct {
+	NotificationID int64
+	UserName       string `gorm:"column:username"`
+	Image          string
+}
+
+// Notification is a group of events of one kind on the same article, newest actors first.
+type Notification struct {
+	ID           int64
+	Kind         string
+	ArticleSlug  string
+	ArticleTitle string
+	ActorsCount  int
+	Actors       []*NotificationActor `gorm:"-"`
+	ReadAt       *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Read reports whether the notification has been read.
+func (n *Notification) Read() bool {
+	return n.ReadAt != nil
+}
+
+// Summary returns a one-line description such as `alice and 4 others favorited "Title"`.
+func (n *Notification) Summary() string {
+	who := "someone"
+	if len(n.Actors) > 0 {
+		who = n.Actors[0].UserName
+		switch {
+		case n.ActorsCount == 2 && len(n.Actors) > 1:
+			who += " and " + n.Actors[1].UserName
+		case n.ActorsCount > 2:
+			who += fmt.Sprintf(" and %d others", n.ActorsCount-1)
+		}
+	}
+	switch n.Kind {
+	case NotificationFollow:
+		return who + " started following you"
+	case NotificationFavorite:
+		return fmt.Sprintf("%s favorited %q", who, n.ArticleTitle)
+	case NotificationComment:
+		return fmt.Sprintf("%s commented on %q", who, n.ArticleTitle)
+	case NotificationMention:
+		return fmt.Sprintf("%s mentioned you in %q", who, n.ArticleTitle)
+	}
+	return who
+}
+
+// NotificationRepo is a notification repo.
+type NotificationRepo interface {
+	// AddNotification 合并进接收人同类型同文章的未读通知，没有时新建，返回通知 id；接收人拉黑了触发人时忽略，id 为 0
+	AddNotification(ctx context.Context, e *NotificationEvent) (int64, error)
+	ListNotifications(ctx context.Context, userID int64, unreadOnly bool, p *Page) ([]*Notification, error)
+	// MarkRead 通知不存在或不属于 userID 时 found 为 false
+	MarkRead(ctx context.Context, userID, id int64) (found bool, err error)
+	MarkAllRead(ctx context.Context, userID int64) error
+	UnreadCount(ctx context.Context, userID int64) (int64, error)
+}
+
+// NotificationUsecase is a notification usecase.
+type NotificationUsecase struct {
+	repo NotificationRepo
+	log  *log.Helper
+}
+
+// NewNotificationUsecase new a notification usecase.
+func NewNotificationUsecase(repo NotificationRepo, logger log.Logger) *NotificationUsecase {
+	return &NotificationUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func notificationCursor(n *Notification) PageCursor {
+	return PageCursor{CreatedAt: n.UpdatedAt, ID: n.ID}
+}
+
+// ListNotifications returns the notifications of myid, most recently updated first, and the next cursor.
+func (uc *NotificationUsecase) ListNotifications(ctx context.Context, myid int64, unreadOnly bool, p *Page) ([]*Notification, *PageCursor, error) {
+	p.normalize()
+	list, err := uc.repo.ListNotifications(ctx, myid, unreadOnly, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, next := cutPage(list, p.Limit, notificationCursor)
+	return list, next, nil
+}
+
+// UnreadCount returns the number of unread notifications of myid.
+func (uc *NotificationUsecase) UnreadCount(ctx context.Context, myid int64) (int64, error) {
+	return uc.repo.UnreadCount(ctx, myid)
+}
+
+// MarkRead marks one notification of myid as read.
+func (uc *NotificationUsecase) MarkRead(ctx context.Context, myid, id int64) error {
+	found, err := uc.repo.MarkRead(ctx, myid, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
+
+// MarkAllRead marks every notification of myid as read.
+func (uc *NotificationUsecase) MarkAllRead(ctx context.Context, myid int64) error {
+	return uc.repo.MarkAllRead(ctx, myid)
+}
+
+// notify 记录一条通知并推送给在线的接收人；自己触发的不通知，失败只记日志，不影响主流程
+func (uc *RealWorldUsecase) notify(ctx context.Context, e *NotificationEvent) {
+	if e.RecipientID == e.ActorID {
+		return
+	}
+	id, err := uc.notes.AddNotification(ctx, e)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("add %s notification error: %v", e.Kind, err)
+		return
+	}
+	if id != 0 {
+		publishLive(ctx, uc.live, uc.log, LiveUserChannel(e.RecipientID), LiveNotification, e.ActorID,
+			&LiveNotificationEvent{ID: id, Kind: e.Kind})
+	}
+}
@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 聚合类型，同一聚合的事件按发生顺序发布
+const (
+	AggregateArticle = "article"
+	AggregateUser    = "user"
+)
+
+// 领域事件类型；评论和收藏属于所在文章的聚合，关注属于被关注用户的聚合
+const (
+	EventArticleCreated       = "article.created"
+	EventArticleUpdated       = "article.updated"
+	EventArticleStatusChanged = "article.status_changed"
+	EventArticleDeleted       = "article.deleted"
+	EventCommentCreated       = "comment.created"
+	EventCommentUpdated       = "comment.updated"
+	EventCommentDeleted       = "comment.deleted"
+	EventArticleFavorited     = "article.favorited"
+	EventArticleUnfavorited   = "article.unfavorited"
+	EventUserFollowed         = "user.followed"
+	EventUserUnfollowed       = "user.unfollowed"
+)
+
+const (
+	defaultOutboxInterval  = time.Second
+	defaultOutboxBatchSize = 100
+	// 已发布的事件保留多久，之后清理
+	defaultOutboxRetention = 7 * 24 * time.Hour
+	// 中继锁的过期时间；一轮中继超过它的一半就停下，避免锁过期后两个实例同时发布打乱顺序
+	outboxLockTTL = time.Minute
+)
+
+// DomainEvent is a change written to the outbox in the same transaction as the change itself.
+type DomainEvent struct {
+	// 自增 id：写入时持有聚合的咨询锁，同一聚合内 id 的先后就是提交的先后；消费方用它去重
+	ID            int64
+	AggregateType string
+	AggregateID   int64
+	Type          string `gorm:"column:event_type"`
+	// JSON，内容见各 EventData
+	Payload   string
+	CreatedAt time.Time
+}
+
+// ArticleEventData is the payload of article events.
+type ArticleEventData struct {
+	ID          int64      `json:"id"`
+	AuthorID    int64      `json:"authorId"`
+	Slug        string     `json:"slug"`
+	Title       string     `json:"title"`
+	Status      string     `json:"status"`
+	Version     int64      `json:"version"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+}
+
+// CommentEventData is the payload of comment events.
+type CommentEventData struct {
+	ID        int64 `json:"id"`
+	ArticleID int64 `json:"articleId"`
+	AuthorID  int64 `json:"authorId"`
+	ParentID  int64 `json:"parentId,omitempty"`
+}
+
+// FavoriteEventData is the payload of favorite events.
+type FavoriteEventData struct {
+	UserID    int64 `json:"userId"`
+	ArticleID int64 `json:"articleId"`
+}
+
+// FollowEventData is the payload of follow events.
+type FollowEventData struct {
+	FollowerID int64 `json:"followerId"`
+	FolloweeID int64 `json:"followeeId"`
+}
+
+// OutboxRepo is an outbox repo. 事件由各仓储在修改数据的事务里写入，这里只负责读取和标记
+type OutboxRepo interface {
+	// ListPending 按 id 顺序返回尚未发布的事件
+	ListPending(ctx context.Context, limit int) ([]*DomainEvent, error)
+	MarkPublished(ctx context.Context, ids []int64) error
+	// DeletePublished 删除 before 之前发布的事件
+	DeletePublished(ctx context.Context, before time.Time) error
+}
+
+// EventPublisher publishes the events relayed from the outbox. An event may be published more than once when
+// the relay fails after publishing it, consumers deduplicate by event ID.
+type EventPublisher interface {
+	Publish(ctx context.Context, e *DomainEvent) error
+}
+
+// OutboxUsecase relays outbox events to the EventPublisher.
+type OutboxUsecase struct {
+	repo      OutboxRepo
+	publisher EventPublisher
+	locker    Locker
+	interval  time.Duration
+	batchSize int
+	retention time.Duration
+	log       *log.Helper
+}
+
+// NewOutboxUsecase new an outbox relay usecase.
+func NewOutboxUsecase(repo OutboxRepo, publisher EventPublisher, locker Locker, c *conf.Biz, logger log.Logger) *OutboxUsecase {
+	uc := &OutboxUsecase{
+		repo:      repo,
+		publisher: publisher,
+		locker:    locker,
+		interval:  defaultOutboxInterval,
+		batchSize: defaultOutboxBatchSize,
+		retention: defaultOutboxRetention,
+		log:       log.NewHelper(logger),
+	}
+	if d := c.GetOutbox().GetInterval(); d != nil && d.AsDuration() > 0 {
+		uc.interval = d.AsDuration()
+	}
+	if n := c.GetOutbox().GetBatchSize(); n > 0 {
+		uc.batchSize = int(n)
+	}
+	if d := c.GetOutbox().GetRetention(); d != nil && d.AsDuration() > 0 {
+		uc.retention = d.AsDuration()
+	}
+	return uc
+}
+
+// Interval returns how often the outbox is polled.
+func (uc *OutboxUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Relay publishes the pending events in order. A failed event stops the relay so that no later event
+// overtakes it; it is retried on the next run.
+// 同一时间只有一个实例在发布，否则两个实例各取一批会打乱顺序
+func (uc *OutboxUsecase) Relay(ctx context.Context) error {
+	unlock, ok, err := uc.locker.TryLock(ctx, "outbox:relay", outboxLockTTL)
+	if err != nil || !ok {
+		return err
+	}
+	defer unlock()
+	start := time.Now()
+	for time.Since(start) < outboxLockTTL/2 {
+		list, err := uc.repo.ListPending(ctx, uc.batchSize)
+		if err != nil {
+			return err
+		}
+		published := make([]int64, 0, len(list))
+		var perr error
+		for _, e := range list {
+			if perr = uc.publisher.Publish(ctx, e); perr != nil {
+				break
+			}
+			published = append(published, e.ID)
+		}
+		// 标记失败时这批事件下一轮会再发一次
+		if len(published) > 0 {
+			if err := uc.repo.MarkPublished(ctx, published); err != nil {
+				return err
+			}
+		}
+		if perr != nil {
+			return perr
+		}
+		if len(list) < uc.batchSize {
+			break
+		}
+	}
+	return uc.repo.DeletePublished(ctx, time.Now().Add(-uc.retention))
+}
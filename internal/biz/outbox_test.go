@@ -0,0 +1,219 @@
+package biz_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memOutboxRepo 内存版的发件箱，markErr 不为空时下一次 MarkPublished 失败
+type memOutboxRepo struct {
+	mu        sync.Mutex
+	events    []*biz.DomainEvent
+	published map[int64]bool
+	markErr   error
+}
+
+func newMemOutboxRepo() *memOutboxRepo {
+	return &memOutboxRepo{published: make(map[int64]bool)}
+}
+
+func (r *memOutboxRepo) append(aggregateID int64, typ string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, &biz.DomainEvent{
+		ID:            int64(len(r.events) + 1),
+		AggregateType: biz.AggregateArticle,
+		AggregateID:   aggregateID,
+		Type:          typ,
+		CreatedAt:     time.Now(),
+	})
+}
+
+func (r *memOutboxRepo) ListPending(ctx context.Context, limit int) ([]*biz.DomainEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []*biz.DomainEvent
+	for _, e := range r.events {
+		if !r.published[e.ID] && len(list) < limit {
+			list = append(list, e)
+		}
+	}
+	return list, nil
+}
+
+func (r *memOutboxRepo) MarkPublished(ctx context.Context, ids []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.markErr; err != nil {
+		r.markErr = nil
+		return err
+	}
+	for _, id := range ids {
+		r.published[id] = true
+	}
+	return nil
+}
+
+func (r *memOutboxRepo) DeletePublished(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+type grantLocker struct{}
+
+func (grantLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	return func() {}, true, nil
+}
+
+type denyLocker struct{}
+
+func (denyLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	return nil, false, nil
+}
+
+// flakyPublisher 在 failID 第一次发布时失败，其余交给 MemoryPublisher
+type flakyPublisher struct {
+	*data.MemoryPublisher
+	failID int64
+}
+
+var errPublish = errors.New("broker unavailable")
+
+func (p *flakyPublisher) Publish(ctx context.Context, e *biz.DomainEvent) error {
+	if e.ID == p.failID {
+		p.failID = 0
+		return errPublish
+	}
+	return p.MemoryPublisher.Publish(ctx, e)
+}
+
+func outboxConf(batchSize int32) *conf.Biz {
+	return &conf.Biz{Outbox: &conf.Biz_Outbox{BatchSize: batchSize}}
+}
+
+func publishedIDs(p *data.MemoryPublisher) []int64 {
+	var ids []int64
+	for _, e := range p.Events() {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRelayPublishesInOrder(t *testing.T) {
+	repo := newMemOutboxRepo()
+	for i := 0; i < 7; i++ {
+		repo.append(int64(i%3+1), biz.EventArticleUpdated)
+	}
+	pub := data.NewMemoryPublisher()
+	// 批大小小于事件数，顺序要跨批保持
+	uc := biz.NewOutboxUsecase(repo, pub, grantLocker{}, outboxConf(3), log.DefaultLogger)
+
+	if err := uc.Relay(context.Background()); err != nil {
+		t.Fatalf("Relay: %v", err)
+	}
+	if got, want := publishedIDs(pub), []int64{1, 2, 3, 4, 5, 6, 7}; !equalIDs(got, want) {
+		t.Fatalf("published %v, want %v", got, want)
+	}
+
+	// 已发布的事件不会再发
+	repo.append(1, biz.EventArticleDeleted)
+	if err := uc.Relay(context.Background()); err != nil {
+		t.Fatalf("Relay: %v", err)
+	}
+	if got, want := publishedIDs(pub), []int64{1, 2, 3, 4, 5, 6, 7, 8}; !equalIDs(got, want) {
+		t.Errorf("published %v, want %v", got, want)
+	}
+}
+
+func TestRelayStopsAtFailedEvent(t *testing.T) {
+	repo := newMemOutboxRepo()
+	for i := 0; i < 5; i++ {
+		repo.append(1, biz.EventArticleUpdated)
+	}
+	pub := &flakyPublisher{MemoryPublisher: data.NewMemoryPublisher(), failID: 3}
+	uc := biz.NewOutboxUsecase(repo, pub, grantLocker{}, outboxConf(10), log.DefaultLogger)
+
+	if err := uc.Relay(context.Background()); !errors.Is(err, errPublish) {
+		t.Fatalf("Relay: got %v, want %v", err, errPublish)
+	}
+	// 失败的事件之后的事件不能抢先发布
+	if got, want := publishedIDs(pub.MemoryPublisher), []int64{1, 2}; !equalIDs(got, want) {
+		t.Fatalf("published %v, want %v", got, want)
+	}
+
+	if err := uc.Relay(context.Background()); err != nil {
+		t.Fatalf("Relay: %v", err)
+	}
+	if got, want := publishedIDs(pub.MemoryPublisher), []int64{1, 2, 3, 4, 5}; !equalIDs(got, want) {
+		t.Errorf("published %v, want %v", got, want)
+	}
+}
+
+func TestRelayRepublishesWhenMarkFails(t *testing.T) {
+	repo := newMemOutboxRepo()
+	for i := 0; i < 4; i++ {
+		repo.append(int64(i+1), biz.EventArticleCreated)
+	}
+	repo.markErr = errors.New("connection reset")
+	pub := data.NewMemoryPublisher()
+	uc := biz.NewOutboxUsecase(repo, pub, grantLocker{}, outboxConf(10), log.DefaultLogger)
+
+	if err := uc.Relay(context.Background()); err == nil {
+		t.Fatal("Relay: want the MarkPublished error")
+	}
+	if err := uc.Relay(context.Background()); err != nil {
+		t.Fatalf("Relay: %v", err)
+	}
+
+	// 至少一次：没标记成功的事件再发一次，顺序不变
+	got := publishedIDs(pub)
+	if want := []int64{1, 2, 3, 4, 1, 2, 3, 4}; !equalIDs(got, want) {
+		t.Fatalf("published %v, want %v", got, want)
+	}
+	// 消费方按 id 去重后每个事件恰好一次
+	seen := make(map[int64]bool)
+	var deduped []int64
+	for _, id := range got {
+		if !seen[id] {
+			seen[id] = true
+			deduped = append(deduped, id)
+		}
+	}
+	if want := []int64{1, 2, 3, 4}; !equalIDs(deduped, want) {
+		t.Errorf("deduplicated %v, want %v", deduped, want)
+	}
+}
+
+func TestRelaySkipsWithoutLock(t *testing.T) {
+	repo := newMemOutboxRepo()
+	repo.append(1, biz.EventArticleCreated)
+	pub := data.NewMemoryPublisher()
+	uc := biz.NewOutboxUsecase(repo, pub, denyLocker{}, outboxConf(10), log.DefaultLogger)
+
+	if err := uc.Relay(context.Background()); err != nil {
+		t.Fatalf("Relay: %v", err)
+	}
+	if n := len(pub.Events()); n != 0 {
+		t.Errorf("published %d events without holding the relay lock", n)
+	}
+}
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// 最后一次活动之后仍算在线的时长
+	defaultPresenceTTL = 30 * time.Minute
+	// 这段时间内的活动不重复刷新，避免每个请求都写一次 Redis 和数据库
+	defaultPresenceRefresh = time.Minute
+	// 一次批量查询最多的用户名
+	maxPresenceUsers = 100
+	// 每批清理的过期在线用户数
+	presenceSweepBatchSize = 100
+)
+
+// Presence is whether a user is online and when they were last active.
+type Presence struct {
+	UserID     int64  `gorm:"column:id"`
+	UserName   string `gorm:"column:username"`
+	Online     bool   `gorm:"-"`
+	LastSeenAt *time.Time
+	// 用户隐藏了在线状态
+	Hidden bool `gorm:"column:hide_presence"`
+}
+
+// LivePresenceEvent tells a user someone they follow came online or went offline.
+type LivePresenceEvent struct {
+	UserName   string    `json:"username"`
+	Online     bool      `json:"online"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+}
+
+// PresenceRepo is a presence repo.
+type PresenceRepo interface {
+	// Touch 记录 userID 在 now 有活动，在线状态保持 ttl；距上次刷新不到 refresh 时什么也不做。
+	// cameOnline 表示之前不在线
+	Touch(ctx context.Context, userID int64, now time.Time, ttl, refresh time.Duration) (cameOnline bool, err error)
+	// ListPresence 批量读取用户的在线状态，不存在的用户名不返回
+	ListPresence(ctx context.Context, usernames []string) ([]*Presence, error)
+	// ExpireOnline 取出最多 limit 个最后活动早于 before、在线状态已经过期的用户，返回最后活动时间；
+	// 每个用户只会被取出一次，多个实例同时调用也不会重复
+	ExpireOnline(ctx context.Context, before time.Time, limit int) (map[int64]time.Time, error)
+	// Online 批量查询用户当前是否在线
+	Online(ctx context.Context, ids []int64) (map[int64]bool, error)
+	SetHidden(ctx context.Context, userID int64, hidden bool) error
+}
+
+// PresenceUsecase is a presence usecase.
+type PresenceUsecase struct {
+	repo     PresenceRepo
+	articles RealWorldRepo
+	live     LiveRepo
+	ttl      time.Duration
+	refresh  time.Duration
+	log      *log.Helper
+}
+
+// NewPresenceUsecase new a presence usecase.
+func NewPresenceUsecase(repo PresenceRepo, articles RealWorldRepo, live LiveRepo, c *conf.Biz, logger log.Logger) *PresenceUsecase {
+	uc := &PresenceUsecase{
+		repo:     repo,
+		articles: articles,
+		live:     live,
+		ttl:      defaultPresenceTTL,
+		refresh:  defaultPresenceRefresh,
+		log:      log.NewHelper(logger),
+	}
+	if d := c.GetPresence().GetOnlineTtl(); d != nil && d.AsDuration() > 0 {
+		uc.ttl = d.AsDuration()
+	}
+	if d := c.GetPresence().GetRefresh(); d != nil && d.AsDuration() > 0 {
+		uc.refresh = d.AsDuration()
+	}
+	// 刷新间隔不能比在线时长还长，否则一直活动的用户也会掉线
+	if uc.refresh > uc.ttl/2 {
+		uc.refresh = uc.ttl / 2
+	}
+	return uc
+}
+
+// Interval returns how often expired presence is swept.
+func (uc *PresenceUsecase) Interval() time.Duration {
+	return uc.refresh
+}
+
+// Touch records activity of myid. When myid was offline and does not hide presence, the followers are told
+// they came online. Failures are only logged, presence never fails the request.
+func (uc *PresenceUsecase) Touch(ctx context.Context, myid int64) {
+	now := time.Now()
+	cameOnline, err := uc.repo.Touch(ctx, myid, now, uc.ttl, uc.refresh)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("touch presence error: %v", err)
+		return
+	}
+	if cameOnline {
+		uc.publishPresence(ctx, myid, true, now)
+	}
+}
+
+// SweepOffline tells the followers of users whose presence expired that they went offline.
+func (uc *PresenceUsecase) SweepOffline(ctx context.Context) error {
+	for {
+		expired, err := uc.repo.ExpireOnline(ctx, time.Now().Add(-uc.ttl), presenceSweepBatchSize)
+		if err != nil {
+			return err
+		}
+		for id, lastSeen := range expired {
+			uc.publishPresence(ctx, id, false, lastSeen)
+		}
+		if len(expired) < presenceSweepBatchSize {
+			return nil
+		}
+	}
+}
+
+// publishPresence 把上线或下线推送给 userID 的关注者；用户隐藏了在线状态时不推送，失败只记日志
+func (uc *PresenceUsecase) publishPresence(ctx context.Context, userID int64, online bool, at time.Time) {
+	user, err := uc.articles.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		uc.log.WithContext(ctx).Warnf("find user error: %v", err)
+		return
+	}
+	if user.HidePresence {
+		return
+	}
+	ids, err := uc.live.FollowerIDs(ctx, userID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("list followers error: %v", err)
+		return
+	}
+	e := &LivePresenceEvent{UserName: user.UserName, Online: online, LastSeenAt: at}
+	for _, id := range ids {
+		publishLive(ctx, uc.live, uc.log, LiveUserChannel(id), LivePresence, userID, e)
+	}
+}
+
+// Presence returns the presence of user as seen by myid. When the online state cannot be read the user is
+// shown offline, a profile should not fail because of presence.
+func (uc *PresenceUsecase) Presence(ctx context.Context, myid int64, user *RealWorld) *Presence {
+	online, err := uc.repo.Online(ctx, []int64{user.ID})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("read presence error: %v", err)
+	}
+	p := &Presence{
+		UserID:     user.ID,
+		UserName:   user.UserName,
+		Online:     online[user.ID],
+		LastSeenAt: user.LastSeenAt,
+		Hidden:     user.HidePresence,
+	}
+	p.hideFrom(myid)
+	return p
+}
+
+// Presences returns the presence of the users with the given usernames as seen by myid, in request order.
+// Unknown usernames are left out.
+func (uc *PresenceUsecase) Presences(ctx context.Context, myid int64, usernames []string) ([]*Presence, error) {
+	seen := make(map[string]bool, len(usernames))
+	names := make([]string, 0, len(usernames))
+	for _, n := range usernames {
+		if n != "" && !seen[n] {
+			seen[n] = true
+			names = append(names, n)
+		}
+	}
+	if len(names) > maxPresenceUsers {
+		return nil, errors.BadRequest(fmt.Sprintf("at most %d usernames at a time", maxPresenceUsers), "")
+	}
+	if len(names) == 0 {
+		return []*Presence{}, nil
+	}
+	list, err := uc.repo.ListPresence(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, len(list))
+	byName := make(map[string]*Presence, len(list))
+	for i, p := range list {
+		ids[i] = p.UserID
+		byName[p.UserName] = p
+	}
+	online, err := uc.repo.Online(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Presence, 0, len(list))
+	for _, n := range names {
+		p, ok := byName[n]
+		if !ok {
+			continue
+		}
+		p.Online = online[p.UserID]
+		p.hideFrom(myid)
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// SetHidden sets whether myid hides their presence from other users.
+func (uc *PresenceUsecase) SetHidden(ctx context.Context, myid int64, hidden bool) error {
+	return uc.repo.SetHidden(ctx, myid, hidden)
+}
+
+// hideFrom 用户隐藏了在线状态时，对自己以外的人显示为离线且没有最后活动时间
+func (p *Presence) hideFrom(myid int64) {
+	if p.Hidden && p.UserID != myid {
+		p.Online = false
+		p.LastSeenAt = nil
+	}
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 表态的对象
+const (
+	ReactionTargetArticle = "article"
+	ReactionTargetComment = "comment"
+)
+
+// 未配置时允许的表态
+var defaultReactionTypes = []string{"like", "love", "insightful", "funny"}
+
+// ReactionCount is the number of one kind of reaction on a target and whether the viewer made it.
+type ReactionCount struct {
+	Reaction string
+	Count    int64
+	Reacted  bool
+}
+
+// ReactionRepo is a reaction repo.
+type ReactionRepo interface {
+	// AddReaction 记录表态，已经表态过时 added 为 false
+	AddReaction(ctx context.Context, target string, targetID, userID int64, reaction string) (added bool, err error)
+	// RemoveReaction 撤销表态，没有表态过时 removed 为 false
+	RemoveReaction(ctx context.Context, target string, targetID, userID int64, reaction string) (removed bool, err error)
+	// ReactionCounts 批量读取计数，key 为对象 id，再按表态分
+	ReactionCounts(ctx context.Context, target string, ids []int64) (map[int64]map[string]int64, error)
+	// UserReactions 批量读取 userID 对这些对象做过的表态
+	UserReactions(ctx context.Context, target string, userID int64, ids []int64) (map[int64][]string, error)
+}
+
+// ReactionUsecase is a reaction usecase.
+type ReactionUsecase struct {
+	repo     ReactionRepo
+	articles RealWorldRepo
+	types    []string
+	log      *log.Helper
+}
+
+// NewReactionUsecase new a reaction usecase.
+func NewReactionUsecase(repo ReactionRepo, articles RealWorldRepo, c *conf.Biz, logger log.Logger) *ReactionUsecase {
+	uc := &ReactionUsecase{
+		repo:     repo,
+		articles: articles,
+		types:    defaultReactionTypes,
+		log:      log.NewHelper(logger),
+	}
+	if t := c.GetReaction().GetTypes(); len(t) > 0 {
+		uc.types = t
+	}
+	return uc
+}
+
+func (uc *ReactionUsecase) validType(reaction string) bool {
+	for _, t := range uc.types {
+		if t == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// reactionTarget 找到 myid 可以表态的文章或评论，返回对象 id
+func (uc *ReactionUsecase) reactionTarget(ctx context.Context, myid int64, slug string, commentID int64) (int64, error) {
+	art, err := uc.articles.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return 0, err
+	}
+	if !art.visibleTo(myid) {
+		return 0, ErrArticleNotFound
+	}
+	if commentID == 0 {
+		return art.ID, nil
+	}
+	c, err := uc.articles.GetComment(ctx, myid, commentID)
+	if err != nil {
+		return 0, err
+	}
+	if c == nil || c.ArticleID != art.ID || c.Deleted() {
+		return 0, ErrCommentNotFound
+	}
+	return c.ID, nil
+}
+
+// React adds or, when add is false, removes a reaction of myid on an article, or on one of its comments
+// when commentID is not 0, and returns the reactions on the target afterwards.
+func (uc *ReactionUsecase) React(ctx context.Context, myid int64, slug string, commentID int64, reaction string, add bool) ([]*ReactionCount, error) {
+	if !uc.validType(reaction) {
+		return nil, errors.BadRequest("unknown reaction", reaction)
+	}
+	id, err := uc.reactionTarget(ctx, myid, slug, commentID)
+	if err != nil {
+		return nil, err
+	}
+	target := ReactionTargetArticle
+	if commentID != 0 {
+		target = ReactionTargetComment
+	}
+	if add {
+		_, err = uc.repo.AddReaction(ctx, target, id, myid, reaction)
+	} else {
+		_, err = uc.repo.RemoveReaction(ctx, target, id, myid, reaction)
+	}
+	if err != nil {
+		return nil, err
+	}
+	m, err := uc.Reactions(ctx, myid, target, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+	return m[id], nil
+}
+
+// Reactions returns the reactions on each target keyed by target id, every configured reaction in order.
+func (uc *ReactionUsecase) Reactions(ctx context.Context, myid int64, target string, ids []int64) (map[int64][]*ReactionCount, error) {
+	res := make(map[int64][]*ReactionCount, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	counts, err := uc.repo.ReactionCounts(ctx, target, ids)
+	if err != nil {
+		return nil, err
+	}
+	mine, err := uc.repo.UserReactions(ctx, target, myid, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		reacted := make(map[string]bool, len(mine[id]))
+		for _, r := range mine[id] {
+			reacted[r] = true
+		}
+		list := make([]*ReactionCount, 0, len(uc.types))
+		for _, t := range uc.types {
+			list = append(list, &ReactionCount{Reaction: t, Count: counts[id][t], Reacted: reacted[t]})
+		}
+		res[id] = list
+	}
+	return res, nil
+}
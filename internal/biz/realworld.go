@@ -7,6 +7,7 @@ import (
 	//"fmt"
 
 	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/conf"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -17,6 +18,8 @@ import (
 var (
 	// ErrUserNotFound is user not found.
 	ErrUserNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrArticleNotFound is article not found.
+	ErrArticleNotFound = errors.NotFound(v1.ErrorReason_ARTICLE_NOT_FOUND.String(), "article not found")
 )
 
 // RealWorld is a RealWorld model.
@@ -30,6 +33,16 @@ type RealWorld struct {
 	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
 	Bio       string    `gorm:"column:bio;" json:"bio"`
 	Image     string    `gorm:"column:image;" json:"image"`
+	// 被封禁的时间，为空表示正常账号；封禁用户不会出现在搜索和推荐中
+	SuspendedAt *time.Time `gorm:"column:suspended_at" json:"suspended_at,omitempty"`
+	// 版主可以查看评论的修改历史
+	Moderator bool `gorm:"column:is_moderator;not null;default:false" json:"-"`
+	// 乐观锁版本号；作为更新参数时表示客户端期望的当前版本
+	Version int64 `gorm:"not null;default:1" json:"version"`
+	// 最后一次带登录态的活动时间
+	LastSeenAt *time.Time `gorm:"column:last_seen_at" json:"-"`
+	// 对其他人隐藏在线状态
+	HidePresence bool `gorm:"column:hide_presence;not null;default:false" json:"-"`
 }
 
 type Article struct {
@@ -41,6 +54,19 @@ type Article struct {
 	AuthorID    int64     `gorm:"not null" json:"author_id"`
 	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTIme" json:"updated_at"`
+	// 文章状态，只有 published 的文章会出现在列表和 feed 中
+	Status      string     `gorm:"size:16;not null;default:published" json:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// 定时发布时间，只对草稿生效，到期后由后台任务发布
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	// 乐观锁版本号；作为更新参数时表示客户端期望的当前版本
+	Version int64 `gorm:"not null;default:1" json:"version"`
+	// 字数、阅读时间和目录，正文变化时重新计算
+	ArticleStats
+	// 独立访客数，由后台任务从 Redis 定期写回
+	ViewsCount int64 `gorm:"not null;default:0" json:"views_count"`
+	// 作为更新参数时表示这次更新是从哪个旧版本恢复的，记入新的修订
+	RestoredFrom *int32 `gorm:"-" json:"-"`
 }
 
 type Tags struct {
@@ -66,31 +92,79 @@ type RealWorldRepo interface {
 	//FindByID(context.Context, int64) (*RealWorld, error)
 	FindByEmail(context.Context, string) (*RealWorld, error)
 	CreateUser(context.Context, *RealWorld) (*RealWorld, error)
-	SetUserOnline(context.Context, int64) error
 	FindByID(context.Context, int64) (*RealWorld, error)
 	FindByUserName(context.Context, string) (*RealWorld, error)
-	UpdateUser(context.Context, *RealWorld) (*RealWorld, error)
+	UpdateUser(context.Context, *RealWorld, []string) (*RealWorld, error)
 	FindAFollowB(context.Context, int64, int64) (bool, error)
 	AFollowB(context.Context, int64, int64) error
 	AUnFollowB(context.Context, int64, int64) error
 	CreateArticle(context.Context, *Article) (*Article, error)
+	// DeleteArticle 连同评论、收藏、历史版本等一起删除
+	DeleteArticle(ctx context.Context, id int64) error
 	CreateTag(context.Context, *Tags) error
 	CreateTags(context.Context, *[]Tags) error
+	LinkArticleTags(context.Context, int64, *[]Tags) error
+	AddFavorite(ctx context.Context, userID, articleID int64) error
+	RemoveFavorite(ctx context.Context, userID, articleID int64) error
+	// TakenSlugs 返回其他文章正在使用或曾经使用过的、以 base 开头的 slug
+	TakenSlugs(ctx context.Context, base string, articleID int64) ([]string, error)
 	GetArticleBySlug(context.Context, string) (*Article, error)
-	UpdateArticle(context.Context, *Article) (*Article, error)
+	UpdateArticle(context.Context, *Article, []string) (*Article, error)
+	ListArticles(context.Context, int64, *ArticleFilter, *Page) ([]*ArticleView, int64, error)
+	FeedArticles(context.Context, int64, *Page) ([]*ArticleView, int64, error)
+	ListDrafts(context.Context, int64, *Page) ([]*ArticleView, int64, error)
+	GetArticleView(context.Context, int64, string) (*ArticleView, error)
+	SetArticleStatus(context.Context, int64, string) (*Article, error)
+	CreateComment(context.Context, *Comment) (*Comment, error)
+	GetComment(context.Context, int64, int64) (*CommentView, error)
+	// ListComments 分页列出文章的顶层评论，parentID 不为 0 时列出该评论的直接回复
+	ListComments(ctx context.Context, myid, articleID, parentID int64, p *Page) ([]*CommentView, error)
+	// DeleteComment 有回复的评论只清空正文留下占位，没有回复的直接删除，并清理因此不再有回复的占位
+	DeleteComment(context.Context, int64) error
+	// UpdateComment 把旧正文存入修改历史后替换为 body；评论发表超过 window 时返回 ErrCommentEditClosed
+	UpdateComment(ctx context.Context, id int64, body string, window time.Duration) error
+	ListCommentEdits(ctx context.Context, id int64) ([]*CommentEdit, error)
+	ListFollowers(context.Context, int64, int64, *Page) ([]*Follower, error)
 	//ListByHello(context.Context, string) ([]*RealWorld, error)
 	//ListAll(context.Context) ([]*RealWorld, error)
 }
 
 // RealWorldUsecase is a RealWorld usecase.
 type RealWorldUsecase struct {
-	repo RealWorldRepo
-	log  *log.Helper
+	repo     RealWorldRepo
+	notes    NotificationRepo
+	mentions MentionRepo
+	live     LiveRepo
+	hooks    WebhookRepo
+	// 是否允许不带期望版本的更新
+	allowUnconditional bool
+	// 回复最多嵌套的层数
+	maxCommentDepth int
+	// 发表后允许作者修改评论的时间
+	commentEditWindow time.Duration
+	log               *log.Helper
 }
 
 // NewRealWorldUsecase new a RealWorld usecase.
-func NewRealWorldUsecase(repo RealWorldRepo, logger log.Logger) *RealWorldUsecase {
-	return &RealWorldUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewRealWorldUsecase(repo RealWorldRepo, notes NotificationRepo, mentions MentionRepo, live LiveRepo, hooks WebhookRepo, c *conf.Biz, logger log.Logger) *RealWorldUsecase {
+	uc := &RealWorldUsecase{
+		repo:               repo,
+		notes:              notes,
+		mentions:           mentions,
+		live:               live,
+		hooks:              hooks,
+		allowUnconditional: c.GetConcurrency().GetAllowUnconditional(),
+		maxCommentDepth:    defaultMaxCommentDepth,
+		commentEditWindow:  defaultCommentEditWindow,
+		log:                log.NewHelper(logger),
+	}
+	if d := c.GetComment().GetMaxDepth(); d > 0 {
+		uc.maxCommentDepth = int(d)
+	}
+	if d := c.GetComment().GetEditWindow(); d != nil && d.AsDuration() > 0 {
+		uc.commentEditWindow = d.AsDuration()
+	}
+	return uc
 }
 
 // CreateRealWorld creates a RealWorld, and returns the new RealWorld.
@@ -108,10 +182,6 @@ func (uc *RealWorldUsecase) Login(ctx context.Context, g *RealWorld) (*RealWorld
 		//检验密码是否正确
 		if CheckPasswordHash(g.Password, user.Password) {
 			//密码正确
-			//此时用户应该事在线状态了 调repo层的接口往redis里面记录数据
-			if err := uc.repo.SetUserOnline(ctx, user.ID); err != nil {
-				return nil, err
-			}
 			return user, nil
 		} else {
 			//密码错误
@@ -152,7 +222,11 @@ func (uc *RealWorldUsecase) GetCurrentUser(ctx context.Context, g *RealWorld) (*
 	return user, nil
 }
 
-func (uc *RealWorldUsecase) UpdateUser(ctx context.Context, g *RealWorld) (*RealWorld, error) {
+// UpdateUser updates the fields of g listed in fields, or its non-empty fields when fields is nil.
+func (uc *RealWorldUsecase) UpdateUser(ctx context.Context, g *RealWorld, fields []string) (*RealWorld, error) {
+	if err := checkUpdateFields(fields, userUpdateFields, g.fieldValue); err != nil {
+		return nil, err
+	}
 	user, err := uc.repo.FindByID(ctx, g.ID)
 	if err != nil {
 		return nil, err
@@ -160,7 +234,10 @@ func (uc *RealWorldUsecase) UpdateUser(ctx context.Context, g *RealWorld) (*Real
 	if user == nil {
 		return nil, errors.Conflict("user is not extit", "")
 	}
-	user_now, err := uc.repo.UpdateUser(ctx, g)
+	if err := uc.checkVersion(g.Version, user.Version); err != nil {
+		return nil, err
+	}
+	user_now, err := uc.repo.UpdateUser(ctx, g, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +284,7 @@ func (uc *RealWorldUsecase) FollowUser(ctx context.Context, myid int64, username
 		if err := uc.repo.AFollowB(ctx, myid, user_be.ID); err != nil {
 			return nil, err
 		} else {
+			uc.notify(ctx, &NotificationEvent{Kind: NotificationFollow, RecipientID: user_be.ID, ActorID: myid})
 			return user_be, nil
 		}
 
@@ -240,21 +318,81 @@ func (uc *RealWorldUsecase) UnFollowUser(ctx context.Context, myid int64, userna
 	}
 }
 
+// Follower is a user following someone, as seen by the viewer.
+type Follower struct {
+	ProfileHit
+	FollowedAt time.Time
+}
+
+// ListFollowers returns the users following username, most recent first, and the next cursor.
+func (uc *RealWorldUsecase) ListFollowers(ctx context.Context, myid int64, username string, p *Page) ([]*Follower, *PageCursor, error) {
+	user, err := uc.repo.FindByUserName(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, ErrUserNotFound
+	}
+	p.normalize()
+	list, err := uc.repo.ListFollowers(ctx, myid, user.ID, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, next := cutPage(list, p.Limit, func(f *Follower) PageCursor {
+		return PageCursor{CreatedAt: f.FollowedAt, ID: f.ID}
+	})
+	return list, next, nil
+}
+
 func (uc *RealWorldUsecase) CreateArticle(ctx context.Context, art *Article, tags *[]string) (*Article, error) {
+	//未指定状态时直接发布，兼容原有客户端
+	if art.Status == "" {
+		art.Status = ArticleStatusPublished
+	}
+	if !ValidCreateStatus(art.Status) {
+		return nil, errors.BadRequest("invalid article status", art.Status)
+	}
+	if art.Status != ArticleStatusDraft {
+		now := time.Now()
+		art.PublishedAt = &now
+	}
 	var t []Tags
 	for i := 0; i < len(*tags); i++ {
 		t = append(t, Tags{
-			ID:   art.ID,
 			Name: (*tags)[i],
 		})
 	}
 	if err := uc.repo.CreateTags(ctx, &t); err != nil { //创建标记 cu
 		return nil, err
 	}
-	return uc.repo.CreateArticle(ctx, art) //创建文章
+	art.ArticleStats = ComputeStats(art.Body)
+	//创建文章，slug 由标题生成，重名时加数字后缀
+	err := uc.withSlug(ctx, art.Title, 0, func(slug string) error {
+		art.Slug = slug
+		created, err := uc.repo.CreateArticle(ctx, art)
+		if err == nil {
+			art = created
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	//关联文章和标签，按标签过滤文章时依赖 article_tags
+	if err := uc.repo.LinkArticleTags(ctx, art.ID, &t); err != nil {
+		return nil, err
+	}
+	uc.syncMentions(ctx, art.AuthorID, art, 0, art.Body)
+	publishFeed(ctx, uc.live, uc.repo, uc.log, art)
+	emitStatusWebhook(ctx, uc.hooks, uc.repo, uc.log, ArticleStatusDraft, art)
+	return art, nil
 }
 
-func (uc *RealWorldUsecase) UpdateArticle(ctx context.Context, art *Article) (*Article, error) {
+// UpdateArticle updates the fields of art listed in fields, or its non-empty fields when fields is nil.
+func (uc *RealWorldUsecase) UpdateArticle(ctx context.Context, art *Article, fields []string) (*Article, error) {
+	if err := checkUpdateFields(fields, articleUpdateFields, art.fieldValue); err != nil {
+		return nil, err
+	}
 	//查找文章是否存在，文章和用户ID是否匹配
 	repart, err := uc.repo.GetArticleBySlug(ctx, art.Slug)
 	if err != nil {
@@ -264,15 +402,68 @@ func (uc *RealWorldUsecase) UpdateArticle(ctx context.Context, art *Article) (*A
 	if repart.AuthorID != art.AuthorID {
 		return nil, fmt.Errorf("you are not the articls' author")
 	}
+	if err := uc.checkVersion(art.Version, repart.Version); err != nil {
+		return nil, err
+	}
 	art.ID = repart.ID
-	upart, err := uc.repo.UpdateArticle(ctx, art)
+	art.Slug = repart.Slug
+	//正文变化时由仓储层一起写入新的统计
+	art.ArticleStats = ComputeStats(art.Body)
+	var upart *Article
+	if !titleChanged(repart, art, fields) {
+		upart, err = uc.repo.UpdateArticle(ctx, art, fields)
+	} else {
+		//标题变了重新生成 slug，旧 slug 由仓储层记入历史继续可用
+		err = uc.withSlug(ctx, art.Title, art.ID, func(slug string) error {
+			art.Slug = slug
+			updated, err := uc.repo.UpdateArticle(ctx, art, fields)
+			if err == nil {
+				upart = updated
+			}
+			return err
+		})
+	}
 	if err != nil {
 		return nil, err
 	}
-	//现查找用户是否存在
+	if bodyChanged(repart, art, fields) {
+		uc.syncMentions(ctx, upart.AuthorID, upart, 0, upart.Body)
+	}
+	if upart.Status == ArticleStatusPublished {
+		emitWebhook(ctx, uc.hooks, uc.repo, uc.log, WebhookArticleUpdated, upart, repart.Slug, nil)
+	}
 	return upart, nil
 }
 
+// bodyChanged 这次更新是否会修改正文
+func bodyChanged(current, up *Article, fields []string) bool {
+	if fields == nil {
+		return up.Body != "" && up.Body != current.Body
+	}
+	for _, f := range fields {
+		if f == "body" {
+			return up.Body != current.Body
+		}
+	}
+	return false
+}
+
+// titleChanged 这次更新是否会修改标题
+func titleChanged(current, up *Article, fields []string) bool {
+	if up.Title == "" || up.Title == current.Title {
+		return false
+	}
+	if fields == nil {
+		return true
+	}
+	for _, f := range fields {
+		if f == "title" {
+			return true
+		}
+	}
+	return false
+}
+
 // 查找用户是否已经存在 repo层
 // 验证密码
 func CheckPasswordHash(password, hash string) bool {
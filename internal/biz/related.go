@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultRelatedCacheTTL = time.Hour
+	defaultRelatedLimit    = 5
+	// 缓存的候选数，读取时过滤掉拉黑和下线的文章后还要够用
+	relatedCandidates = 30
+)
+
+// RelatedWeights weighs each signal in the related articles score.
+type RelatedWeights struct {
+	// 每个共同标签
+	Tag float64
+	// 同一作者
+	Author float64
+	// 与原文标题、摘要的全文相似度，ts_rank 的值一般远小于 1
+	Text float64
+}
+
+var defaultRelatedWeights = RelatedWeights{Tag: 3, Author: 2, Text: 10}
+
+// RelatedRepo is a related articles repo.
+type RelatedRepo interface {
+	// GetRelatedIDs 读取缓存的候选文章，没有缓存时 ok 为 false
+	GetRelatedIDs(ctx context.Context, articleID int64) (ids []int64, ok bool, err error)
+	SaveRelatedIDs(ctx context.Context, articleID int64, ids []int64, ttl time.Duration) error
+	// ComputeRelated 从数据库计算与文章最相关的已发布文章，与读者无关
+	ComputeRelated(ctx context.Context, articleID int64, w RelatedWeights, limit int) ([]int64, error)
+	// ListRelatedArticles 按 ids 的顺序返回对 myid 可见的已发布文章，跳过双向拉黑的作者
+	ListRelatedArticles(ctx context.Context, myid int64, ids []int64) ([]*ArticleView, error)
+}
+
+// RelatedUsecase is a related articles usecase.
+type RelatedUsecase struct {
+	repo     RelatedRepo
+	articles RealWorldRepo
+	ttl      time.Duration
+	log      *log.Helper
+}
+
+// NewRelatedUsecase new a related articles usecase.
+func NewRelatedUsecase(repo RelatedRepo, articles RealWorldRepo, c *conf.Biz, logger log.Logger) *RelatedUsecase {
+	uc := &RelatedUsecase{
+		repo:     repo,
+		articles: articles,
+		ttl:      defaultRelatedCacheTTL,
+		log:      log.NewHelper(logger),
+	}
+	if d := c.GetRelated().GetCacheTtl(); d != nil && d.AsDuration() > 0 {
+		uc.ttl = d.AsDuration()
+	}
+	return uc
+}
+
+// RelatedArticles returns the published articles most related to the article with slug.
+func (uc *RelatedUsecase) RelatedArticles(ctx context.Context, myid int64, slug string, limit int) ([]*ArticleView, error) {
+	art, err := uc.articles.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !art.visibleTo(myid) {
+		return nil, ErrArticleNotFound
+	}
+	limit = clampLimit(limit, defaultRelatedLimit, relatedCandidates)
+
+	ids, ok, err := uc.repo.GetRelatedIDs(ctx, art.ID)
+	if err != nil {
+		// 缓存不可用时直接计算
+		uc.log.WithContext(ctx).Warnf("get related articles: %v", err)
+	}
+	if !ok {
+		if ids, err = uc.repo.ComputeRelated(ctx, art.ID, defaultRelatedWeights, relatedCandidates); err != nil {
+			return nil, err
+		}
+		if err := uc.repo.SaveRelatedIDs(ctx, art.ID, ids, uc.ttl); err != nil {
+			uc.log.WithContext(ctx).Warnf("cache related articles: %v", err)
+		}
+	}
+	list, err := uc.repo.ListRelatedArticles(ctx, myid, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
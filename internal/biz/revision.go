@@ -0,0 +1,187 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ErrRevisionNotFound is revision not found.
+var ErrRevisionNotFound = errors.NotFound(v1.ErrorReason_REVISION_NOT_FOUND.String(), "revision not found")
+
+// 统一 diff 的上下文行数，与 diff -u 默认一致
+const diffContextLines = 3
+
+// ArticleRevision is a snapshot of an article's content taken on every create, update and restore.
+type ArticleRevision struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement" json:"id"`
+	ArticleID   int64  `gorm:"not null" json:"article_id"`
+	Revision    int32  `gorm:"not null" json:"revision"`
+	Title       string `gorm:"size:255;not null" json:"title"`
+	Description string `gorm:"type:text" json:"description"`
+	Body        string `gorm:"type:text" json:"body"`
+	EditorID    int64  `json:"editor_id"`
+	// 由旧版本恢复而来时记录来源版本号
+	RestoredFrom *int32    `json:"restored_from,omitempty"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (ArticleRevision) TableName() string {
+	return "article_revisions"
+}
+
+// RevisionView is a revision with its editor.
+type RevisionView struct {
+	ArticleRevision
+	EditorName  string
+	EditorBio   string
+	EditorImage string
+}
+
+// RevisionRepo is an article revision repo.
+type RevisionRepo interface {
+	ListRevisions(ctx context.Context, articleID int64, p *Page) ([]*RevisionView, error)
+	GetRevision(ctx context.Context, articleID int64, revision int32) (*RevisionView, error)
+}
+
+// RevisionUsecase is an article revision usecase.
+type RevisionUsecase struct {
+	repo     RevisionRepo
+	articles RealWorldRepo
+	// 恢复旧版本和普通修改走同一条路径：版本检查、slug、提及和 webhook
+	updater *RealWorldUsecase
+	log     *log.Helper
+}
+
+// NewRevisionUsecase new an article revision usecase.
+func NewRevisionUsecase(repo RevisionRepo, articles RealWorldRepo, updater *RealWorldUsecase, logger log.Logger) *RevisionUsecase {
+	return &RevisionUsecase{repo: repo, articles: articles, updater: updater, log: log.NewHelper(logger)}
+}
+
+// authorArticle 修订历史里可能有未公开的内容，只给作者看
+func (uc *RevisionUsecase) authorArticle(ctx context.Context, myid int64, slug string) (*Article, error) {
+	art, err := uc.articles.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !art.visibleTo(myid) {
+		return nil, ErrArticleNotFound
+	}
+	if art.AuthorID != myid {
+		return nil, errors.Forbidden("you are not the article's author", "")
+	}
+	return art, nil
+}
+
+// ListRevisions returns the revisions of an article of myid, newest first.
+func (uc *RevisionUsecase) ListRevisions(ctx context.Context, myid int64, slug string, p *Page) ([]*RevisionView, *PageCursor, error) {
+	art, err := uc.authorArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	p.normalize()
+	list, err := uc.repo.ListRevisions(ctx, art.ID, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, next := cutPage(list, p.Limit, func(r *RevisionView) PageCursor {
+		return PageCursor{CreatedAt: r.CreatedAt, ID: r.ID}
+	})
+	return list, next, nil
+}
+
+// GetRevision returns a single revision of an article of myid.
+func (uc *RevisionUsecase) GetRevision(ctx context.Context, myid int64, slug string, revision int32) (*RevisionView, error) {
+	art, err := uc.authorArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.GetRevision(ctx, art.ID, revision)
+}
+
+// DiffRevisions returns a line-level unified diff from one revision of an article to another.
+// 标题、摘要、正文各自作为一个"文件"输出，没有变化的字段不出现
+func (uc *RevisionUsecase) DiffRevisions(ctx context.Context, myid int64, slug string, from, to int32) (string, error) {
+	art, err := uc.authorArticle(ctx, myid, slug)
+	if err != nil {
+		return "", err
+	}
+	a, err := uc.repo.GetRevision(ctx, art.ID, from)
+	if err != nil {
+		return "", err
+	}
+	b, err := uc.repo.GetRevision(ctx, art.ID, to)
+	if err != nil {
+		return "", err
+	}
+	fields := []struct {
+		name string
+		a, b string
+	}{
+		{"title", a.Title, b.Title},
+		{"description", a.Description, b.Description},
+		{"body", a.Body, b.Body},
+	}
+	var sb strings.Builder
+	for _, f := range fields {
+		if f.a == f.b {
+			continue
+		}
+		err := difflib.WriteUnifiedDiff(&sb, difflib.UnifiedDiff{
+			A:        splitLines(f.a),
+			B:        splitLines(f.b),
+			FromFile: "a/" + f.name,
+			FromDate: fmt.Sprintf("revision %d", from),
+			ToFile:   "b/" + f.name,
+			ToDate:   fmt.Sprintf("revision %d", to),
+			Context:  diffContextLines,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+// RestoreRevision restores an old revision of an article of myid as its newest revision. version is the
+// version the client expects the article to be at, as for UpdateArticle.
+func (uc *RevisionUsecase) RestoreRevision(ctx context.Context, myid int64, slug string, revision int32, version int64) (*Article, error) {
+	art, err := uc.authorArticle(ctx, myid, slug)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := uc.repo.GetRevision(ctx, art.ID, revision)
+	if err != nil {
+		return nil, err
+	}
+	// 三个字段都带上 mask，空摘要也会写回去
+	return uc.updater.UpdateArticle(ctx, &Article{
+		AuthorID:     myid,
+		Slug:         art.Slug,
+		Title:        rev.Title,
+		Description:  rev.Description,
+		Body:         rev.Body,
+		Version:      version,
+		RestoredFrom: &rev.Revision,
+	}, []string{"title", "description", "body"})
+}
+
+// splitLines 按行切分并保证每行以换行结尾，空串视为没有行
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSchedulerInterval = 30 * time.Second
+	// 每轮最多发布的文章数，剩下的留给下一轮
+	scheduleBatchSize = 100
+	// 单篇文章发布锁的过期时间，远大于一次发布的耗时
+	publishLockTTL = time.Minute
+)
+
+// ScheduleRepo is a scheduled publishing repo.
+type ScheduleRepo interface {
+	SetArticleSchedule(ctx context.Context, id int64, at *time.Time) (*Article, error)
+	// ListDueArticleIDs 返回定时时间已到、仍是草稿的文章
+	ListDueArticleIDs(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	// PublishScheduledArticle 条件更新：只有仍是到期草稿时才发布，返回发布后的文章，没有发布时为 nil
+	PublishScheduledArticle(ctx context.Context, id int64, now time.Time) (*Article, error)
+}
+
+// ScheduleUsecase is a scheduled publishing usecase.
+type ScheduleUsecase struct {
+	repo     ScheduleRepo
+	articles RealWorldRepo
+	locker   Locker
+	live     LiveRepo
+	hooks    WebhookRepo
+	// 定时发布和手动发布一样要通知草稿里提及的用户
+	notifier *RealWorldUsecase
+	interval time.Duration
+	log      *log.Helper
+}
+
+// NewScheduleUsecase new a scheduled publishing usecase.
+func NewScheduleUsecase(repo ScheduleRepo, articles RealWorldRepo, locker Locker, live LiveRepo, hooks WebhookRepo, notifier *RealWorldUsecase, c *conf.Biz, logger log.Logger) *ScheduleUsecase {
+	uc := &ScheduleUsecase{
+		repo:     repo,
+		articles: articles,
+		locker:   locker,
+		live:     live,
+		hooks:    hooks,
+		notifier: notifier,
+		interval: defaultSchedulerInterval,
+		log:      log.NewHelper(logger),
+	}
+	if d := c.GetScheduler().GetInterval(); d != nil && d.AsDuration() > 0 {
+		uc.interval = d.AsDuration()
+	}
+	return uc
+}
+
+// Interval returns how often due articles are looked for.
+func (uc *ScheduleUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// ScheduleArticle schedules a draft of myid to be published at the given time, a nil time cancels it.
+func (uc *ScheduleUsecase) ScheduleArticle(ctx context.Context, myid int64, slug string, at *time.Time) (*Article, error) {
+	art, err := uc.articles.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !art.visibleTo(myid) {
+		return nil, ErrArticleNotFound
+	}
+	if art.AuthorID != myid {
+		return nil, errors.Forbidden("you are not the article's author", "")
+	}
+	if art.Status != ArticleStatusDraft {
+		return nil, errors.Conflict("only drafts can be scheduled", art.Status)
+	}
+	if at != nil && !at.After(time.Now()) {
+		return nil, errors.BadRequest("scheduled time must be in the future", "")
+	}
+	return uc.repo.SetArticleSchedule(ctx, art.ID, at)
+}
+
+// PublishDue publishes every article whose scheduled time has passed.
+// 多个实例会同时扫描，用分布式锁加条件更新保证每篇文章只发布一次
+func (uc *ScheduleUsecase) PublishDue(ctx context.Context) error {
+	now := time.Now()
+	ids, err := uc.repo.ListDueArticleIDs(ctx, now, scheduleBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := uc.publishOne(ctx, id, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ScheduleUsecase) publishOne(ctx context.Context, id int64, now time.Time) error {
+	unlock, ok, err := uc.locker.TryLock(ctx, "article:publish:"+strconv.FormatInt(id, 10), publishLockTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// 其他实例正在发布这篇
+		return nil
+	}
+	defer unlock()
+	art, err := uc.repo.PublishScheduledArticle(ctx, id, now)
+	if err != nil {
+		return err
+	}
+	if art != nil {
+		uc.log.WithContext(ctx).Infof("scheduled article %d published", id)
+		uc.notifier.notifyArticleMentions(ctx, art)
+		publishFeed(ctx, uc.live, uc.articles, uc.log, art)
+		emitStatusWebhook(ctx, uc.hooks, uc.articles, uc.log, ArticleStatusDraft, art)
+	}
+	return nil
+}
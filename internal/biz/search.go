@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// ProfileHit is a user matched by a search, as seen by the searcher.
+type ProfileHit struct {
+	ID             int64
+	UserName       string
+	Bio            string
+	Image          string
+	Following      bool
+	FollowersCount int64
+}
+
+// ArticleHit is an article matched by a full-text search, as seen by the searcher.
+type ArticleHit struct {
+	ArticleView
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+// ArticleSearch is the query and filters of a full-text article search.
+type ArticleSearch struct {
+	Query  string
+	Tag    string
+	Author string
+	Limit  int
+	Offset int
+}
+
+// SearchRepo is a search repo.
+type SearchRepo interface {
+	// SearchUsers 模糊匹配用户名和简介，按粉丝数排序
+	SearchUsers(ctx context.Context, myid int64, q string, limit, offset int) ([]*ProfileHit, error)
+	// AutocompleteUsers 按用户名前缀匹配，按粉丝数排序
+	AutocompleteUsers(ctx context.Context, myid int64, prefix string, limit int) ([]*ProfileHit, error)
+	// SearchArticles 在文章的 tsvector 上做全文检索，返回当前页和命中总数
+	SearchArticles(ctx context.Context, myid int64, s *ArticleSearch) ([]*ArticleHit, int64, error)
+}
+
+// SearchUsecase is a search usecase.
+type SearchUsecase struct {
+	repo SearchRepo
+	log  *log.Helper
+}
+
+// NewSearchUsecase new a search usecase.
+func NewSearchUsecase(repo SearchRepo, logger log.Logger) *SearchUsecase {
+	return &SearchUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// SearchUsers finds users by username or bio. With autocomplete only username prefixes are matched.
+func (uc *SearchUsecase) SearchUsers(ctx context.Context, myid int64, q string, autocomplete bool, limit, offset int) ([]*ProfileHit, error) {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return nil, errors.BadRequest("search query is empty", "")
+	}
+	limit = clampLimit(limit, defaultSearchLimit, maxSearchLimit)
+	if offset < 0 {
+		offset = 0
+	}
+	if autocomplete {
+		return uc.repo.AutocompleteUsers(ctx, myid, q, limit)
+	}
+	return uc.repo.SearchUsers(ctx, myid, q, limit, offset)
+}
+
+// SearchArticles runs a ranked full-text search over article titles, descriptions and bodies.
+func (uc *SearchUsecase) SearchArticles(ctx context.Context, myid int64, s *ArticleSearch) ([]*ArticleHit, int64, error) {
+	s.Query = strings.TrimSpace(s.Query)
+	if s.Query == "" {
+		return nil, 0, errors.BadRequest("search query is empty", "")
+	}
+	s.Limit = clampLimit(s.Limit, defaultSearchLimit, maxSearchLimit)
+	if s.Offset < 0 {
+		s.Offset = 0
+	}
+	return uc.repo.SearchArticles(ctx, myid, s)
+}
+
+// clampLimit 给分页的 limit 设置默认值和上限
+func clampLimit(limit, def, max int) int {
+	if limit <= 0 {
+		return def
+	}
+	if limit > max {
+		return max
+	}
+	return limit
+}
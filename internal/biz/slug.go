@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/pkg/slug"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 检查可用 slug 和写入之间可能被并发请求抢占，撞上唯一索引时重新选一次
+const slugAttempts = 3
+
+// ErrSlugTaken is returned by the repo when a slug hits the unique index.
+var ErrSlugTaken = errors.Conflict(v1.ErrorReason_SLUG_CONFLICT.String(), "slug is already taken")
+
+// availableSlug 根据标题生成 slug，和其他文章的当前或历史 slug 冲突时加数字后缀
+func (uc *RealWorldUsecase) availableSlug(ctx context.Context, title string, articleID int64) (string, error) {
+	base := slug.Make(title)
+	taken, err := uc.repo.TakenSlugs(ctx, base, articleID)
+	if err != nil {
+		return "", err
+	}
+	return slug.Unique(base, taken), nil
+}
+
+// withSlug 为 title 选一个可用的 slug 交给 fn 写入，被抢占时换一个重试
+func (uc *RealWorldUsecase) withSlug(ctx context.Context, title string, articleID int64, fn func(slug string) error) error {
+	for attempt := 1; ; attempt++ {
+		s, err := uc.availableSlug(ctx, title, articleID)
+		if err != nil {
+			return err
+		}
+		err = fn(s)
+		if errors.Is(err, ErrSlugTaken) && attempt < slugAttempts {
+			continue
+		}
+		return err
+	}
+}
@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"kratos-realworld/internal/pkg/markdown"
+)
+
+// 阅读速度：英文按词计，中日韩文字按字计
+const (
+	wordsPerMinute    = 230
+	cjkCharsPerMinute = 500
+)
+
+// Headings is the table of contents of an article, stored as JSON.
+type Headings []markdown.Heading
+
+// Value implements driver.Valuer.
+func (h Headings) Value() (driver.Value, error) {
+	if h == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(h)
+	return string(b), err
+}
+
+// Scan implements sql.Scanner.
+func (h *Headings) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*h = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, h)
+	case string:
+		return json.Unmarshal([]byte(v), h)
+	}
+	return fmt.Errorf("unsupported toc type %T", src)
+}
+
+// ArticleStats is derived from the Markdown body whenever it changes.
+type ArticleStats struct {
+	WordCount      int      `gorm:"not null;default:0" json:"word_count"`
+	ReadingMinutes int      `gorm:"not null;default:0" json:"reading_minutes"`
+	TOC            Headings `gorm:"column:toc;type:jsonb" json:"toc"`
+}
+
+// ComputeStats counts the words of a Markdown body, estimates its reading time and builds its outline.
+func ComputeStats(body string) ArticleStats {
+	s := markdown.Analyze(body)
+	minutes := math.Ceil(float64(s.Words)/wordsPerMinute + float64(s.CJKChars)/cjkCharsPerMinute)
+	return ArticleStats{
+		WordCount:      s.Words + s.CJKChars,
+		ReadingMinutes: int(minutes),
+		TOC:            s.Headings,
+	}
+}
@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSuggestionInterval = 10 * time.Minute
+	defaultSuggestionLimit    = 50
+	// 每批预计算的用户数
+	suggestionBatchSize = 200
+)
+
+// Suggestion is a precomputed who-to-follow candidate.
+type Suggestion struct {
+	UserID   int64   `json:"user_id"`
+	UserName string  `json:"username"`
+	Bio      string  `json:"bio"`
+	Image    string  `json:"image"`
+	Score    float64 `json:"score"`
+}
+
+// SuggestionRepo is a who-to-follow repo.
+type SuggestionRepo interface {
+	// ListActiveUserIDs 返回 id 大于 afterID 且有关注或收藏记录的用户
+	ListActiveUserIDs(context.Context, int64, int) ([]int64, error)
+	// ComputeSuggestions 从数据库实时计算某个用户的推荐列表
+	ComputeSuggestions(context.Context, int64, int) ([]*Suggestion, error)
+	SaveSuggestions(context.Context, int64, []*Suggestion, time.Duration) error
+	GetSuggestions(context.Context, int64) ([]*Suggestion, error)
+	DropSuggestion(context.Context, int64, int64) error
+}
+
+// SuggestionUsecase is a who-to-follow usecase.
+type SuggestionUsecase struct {
+	repo     SuggestionRepo
+	interval time.Duration
+	limit    int
+	log      *log.Helper
+}
+
+// NewSuggestionUsecase new a who-to-follow usecase.
+func NewSuggestionUsecase(repo SuggestionRepo, c *conf.Biz, logger log.Logger) *SuggestionUsecase {
+	uc := &SuggestionUsecase{
+		repo:     repo,
+		interval: defaultSuggestionInterval,
+		limit:    defaultSuggestionLimit,
+		log:      log.NewHelper(logger),
+	}
+	if d := c.GetSuggestion().GetInterval(); d != nil && d.AsDuration() > 0 {
+		uc.interval = d.AsDuration()
+	}
+	if l := c.GetSuggestion().GetLimit(); l > 0 {
+		uc.limit = int(l)
+	}
+	return uc
+}
+
+// Interval returns how often suggestions are recomputed.
+func (uc *SuggestionUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// ListSuggestions returns the precomputed suggestions of a user, at most limit entries.
+func (uc *SuggestionUsecase) ListSuggestions(ctx context.Context, myid int64, limit int) ([]*Suggestion, error) {
+	list, err := uc.repo.GetSuggestions(ctx, myid)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// Forget removes a user from someone's cached suggestions, e.g. right after following them.
+func (uc *SuggestionUsecase) Forget(ctx context.Context, myid int64, otherid int64) {
+	//推荐缓存只是优化，失败了等下一轮预计算即可
+	if err := uc.repo.DropSuggestion(ctx, myid, otherid); err != nil {
+		uc.log.WithContext(ctx).Warnf("drop suggestion %d for user %d: %v", otherid, myid, err)
+	}
+}
+
+// Refresh recomputes the suggestions of every active user and stores them in the cache.
+func (uc *SuggestionUsecase) Refresh(ctx context.Context) error {
+	var (
+		after int64
+		total int
+	)
+	for {
+		ids, err := uc.repo.ListActiveUserIDs(ctx, after, suggestionBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			list, err := uc.repo.ComputeSuggestions(ctx, id, uc.limit)
+			if err != nil {
+				return err
+			}
+			//缓存多保留一个周期，预计算任务挂掉时旧数据也会自然过期
+			if err := uc.repo.SaveSuggestions(ctx, id, list, 2*uc.interval); err != nil {
+				return err
+			}
+		}
+		total += len(ids)
+		if len(ids) < suggestionBatchSize {
+			break
+		}
+		after = ids[len(ids)-1]
+	}
+	uc.log.WithContext(ctx).Infof("suggestions refreshed for %d users", total)
+	return nil
+}
@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultTrendingWindow   = 7 * 24 * time.Hour
+	defaultTrendingHalfLife = 24 * time.Hour
+	defaultViewsFlush       = time.Minute
+	// 每批落库的 (文章, 日期) 数
+	viewsFlushBatchSize = 500
+)
+
+// TrendingWeights weighs each kind of activity in the trending score.
+type TrendingWeights struct {
+	View     float64
+	Favorite float64
+	Comment  float64
+}
+
+// 收藏和评论比阅读更能说明文章受欢迎
+var defaultTrendingWeights = TrendingWeights{View: 1, Favorite: 5, Comment: 3}
+
+// TrendingQuery selects the articles ranked by TrendingArticles.
+type TrendingQuery struct {
+	// 统计最近多长时间内的活动；距今时间在数据库里用 NOW() 计算，不依赖应用服务器的时钟和时区
+	Window   time.Duration
+	HalfLife time.Duration
+	Weights  TrendingWeights
+	Limit    int
+	Offset   int
+}
+
+// ViewRepo is an article view counter repo.
+type ViewRepo interface {
+	// RecordView 把访客计入文章的总数和当天的 HyperLogLog，同一访客重复访问不重复计数
+	RecordView(ctx context.Context, articleID int64, viewer string, at time.Time, keep time.Duration) error
+	// FlushViews 把最多 limit 个有新访问的 (文章, 日期) 写回数据库，返回处理的个数
+	FlushViews(ctx context.Context, limit int) (int, error)
+	TrendingArticles(ctx context.Context, myid int64, q *TrendingQuery) ([]*ArticleView, int64, error)
+}
+
+// TrendingUsecase is an article view and trending usecase.
+type TrendingUsecase struct {
+	repo     ViewRepo
+	window   time.Duration
+	halfLife time.Duration
+	flush    time.Duration
+	log      *log.Helper
+}
+
+// NewTrendingUsecase new an article view and trending usecase.
+func NewTrendingUsecase(repo ViewRepo, c *conf.Biz, logger log.Logger) *TrendingUsecase {
+	uc := &TrendingUsecase{
+		repo:     repo,
+		window:   defaultTrendingWindow,
+		halfLife: defaultTrendingHalfLife,
+		flush:    defaultViewsFlush,
+		log:      log.NewHelper(logger),
+	}
+	if d := c.GetTrending().GetWindow(); d != nil && d.AsDuration() > 0 {
+		uc.window = d.AsDuration()
+	}
+	if d := c.GetTrending().GetHalfLife(); d != nil && d.AsDuration() > 0 {
+		uc.halfLife = d.AsDuration()
+	}
+	if d := c.GetTrending().GetFlushInterval(); d != nil && d.AsDuration() > 0 {
+		uc.flush = d.AsDuration()
+	}
+	return uc
+}
+
+// FlushInterval returns how often view counts are written back to the database.
+func (uc *TrendingUsecase) FlushInterval() time.Duration {
+	return uc.flush
+}
+
+// RecordView counts a view of an article by viewer, a user or an anonymous client fingerprint.
+// 作者看自己的文章不计数；计数失败只记日志，不影响阅读
+func (uc *TrendingUsecase) RecordView(ctx context.Context, art *Article, myid int64, viewer string) {
+	if art.AuthorID == myid || art.Status != ArticleStatusPublished || viewer == "" {
+		return
+	}
+	// 按天的计数在窗口之外就没用了，多留两天给还没落库的数据
+	if err := uc.repo.RecordView(ctx, art.ID, viewer, time.Now(), uc.window+48*time.Hour); err != nil {
+		uc.log.WithContext(ctx).Warnf("record article view: %v", err)
+	}
+}
+
+// FlushViews writes the pending view counts back to the database.
+func (uc *TrendingUsecase) FlushViews(ctx context.Context) error {
+	for {
+		n, err := uc.repo.FlushViews(ctx, viewsFlushBatchSize)
+		if err != nil {
+			return err
+		}
+		if n < viewsFlushBatchSize {
+			return nil
+		}
+	}
+}
+
+// TrendingArticles returns published articles ranked by time-decayed views, favorites and comments within the window.
+func (uc *TrendingUsecase) TrendingArticles(ctx context.Context, myid int64, limit, offset int) ([]*ArticleView, int64, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.repo.TrendingArticles(ctx, myid, &TrendingQuery{
+		Window:   uc.window,
+		HalfLife: uc.halfLife,
+		Weights:  defaultTrendingWeights,
+		Limit:    clampLimit(limit, defaultPageLimit, maxPageLimit),
+		Offset:   offset,
+	})
+}
@@ -0,0 +1,57 @@
+package biz
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 可以通过 update_mask 修改的字段；值为 true 的字段不允许清空
+var (
+	userUpdateFields = map[string]bool{
+		"username": true,
+		"bio":      false,
+		"image":    false,
+	}
+	articleUpdateFields = map[string]bool{
+		"title":       true,
+		"description": false,
+		"body":        true,
+	}
+)
+
+// checkUpdateFields 校验 update_mask 里的字段；fields 为 nil 表示没有 mask，只更新非空字段
+func checkUpdateFields(fields []string, allowed map[string]bool, value func(string) string) error {
+	for _, f := range fields {
+		required, ok := allowed[f]
+		if !ok {
+			return errors.BadRequest("field cannot be updated", f)
+		}
+		if required && value(f) == "" {
+			return errors.BadRequest("field cannot be empty", f)
+		}
+	}
+	return nil
+}
+
+func (g *RealWorld) fieldValue(f string) string {
+	switch f {
+	case "username":
+		return g.UserName
+	case "bio":
+		return g.Bio
+	case "image":
+		return g.Image
+	}
+	return ""
+}
+
+func (a *Article) fieldValue(f string) string {
+	switch f {
+	case "title":
+		return a.Title
+	case "description":
+		return a.Description
+	case "body":
+		return a.Body
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package biz
+
+import (
+	v1 "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// AnyVersion matches whatever version the resource currently has (If-Match: *).
+const AnyVersion int64 = -1
+
+var (
+	// ErrVersionConflict is returned when the resource was modified since the version the client read.
+	ErrVersionConflict = errors.Conflict(v1.ErrorReason_VERSION_CONFLICT.String(), "resource was modified by someone else")
+	// ErrVersionRequired is returned when an update carries no expected version and unconditional updates are disabled.
+	ErrVersionRequired = errors.New(428, v1.ErrorReason_VERSION_REQUIRED.String(), "If-Match header or version is required")
+)
+
+// checkVersion 校验更新请求带的期望版本；0 表示客户端没有带版本
+func (uc *RealWorldUsecase) checkVersion(expect int64, current int64) error {
+	switch {
+	case expect == 0:
+		if !uc.allowUnconditional {
+			return ErrVersionRequired
+		}
+	case expect != AnyVersion && expect != current:
+		return ErrVersionConflict
+	}
+	return nil
+}
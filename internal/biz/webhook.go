@@ -0,0 +1,516 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// webhook 事件类型
+const (
+	WebhookArticlePublished   = "article.published"
+	WebhookArticleUpdated     = "article.updated"
+	WebhookArticleUnpublished = "article.unpublished"
+	WebhookArticleDeleted     = "article.deleted"
+	WebhookCommentCreated     = "comment.created"
+)
+
+var webhookEvents = []string{
+	WebhookArticlePublished,
+	WebhookArticleUpdated,
+	WebhookArticleUnpublished,
+	WebhookArticleDeleted,
+	WebhookCommentCreated,
+}
+
+// 投递状态
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+const (
+	defaultWebhookInterval    = time.Second
+	defaultWebhookTimeout     = 10 * time.Second
+	defaultWebhookMaxAttempts = 8
+	defaultWebhookBackoff     = 10 * time.Second
+	defaultWebhookMaxBackoff  = time.Hour
+	// 每轮最多领取的投递数
+	webhookBatchSize = 50
+	// 同时进行的投递数，一个慢的接收方不会挡住其他投递
+	webhookConcurrency = 8
+	// 领取后超过投递超时再加这段时间还没有结果（实例在投递中退出），投递重新可见
+	webhookLeaseMargin = 30 * time.Second
+	// 每个用户最多注册的 webhook
+	maxWebhooksPerUser  = 10
+	maxWebhookSecretLen = 128
+)
+
+// ErrWebhookNotFound is webhook not found.
+var ErrWebhookNotFound = errors.NotFound(v1.ErrorReason_WEBHOOK_NOT_FOUND.String(), "webhook not found")
+
+// Webhook is an endpoint that receives content events of its owner's articles, or of every article when Global.
+type Webhook struct {
+	ID     int64
+	UserID int64
+	URL    string
+	Secret string
+	// 逗号分隔的事件类型，为空表示全部
+	Events    string
+	Global    bool `gorm:"column:is_global"`
+	CreatedAt time.Time
+}
+
+// EventList returns the events the webhook subscribes to, nil meaning all of them.
+func (w *Webhook) EventList() []string {
+	if w.Events == "" {
+		return nil
+	}
+	return strings.Split(w.Events, ",")
+}
+
+// WebhookDelivery is one event sent to one webhook, with the result of the last attempt.
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	NextAttemptAt  *time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	// 投递时连表带出的 webhook 地址和密钥
+	URL    string `gorm:"->"`
+	Secret string `gorm:"->"`
+}
+
+// WebhookPayload is the JSON body of a delivery.
+type WebhookPayload struct {
+	// 同一事件投递给多个 webhook 时相同，接收方可以用来去重
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Article   *WebhookArticle `json:"article"`
+	Comment   *WebhookComment `json:"comment,omitempty"`
+}
+
+// WebhookArticle is the article an event is about.
+type WebhookArticle struct {
+	Slug string `json:"slug"`
+	// 标题修改导致 slug 变化时为原来的 slug
+	PreviousSlug string     `json:"previousSlug,omitempty"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	Status       string     `json:"status"`
+	Author       string     `json:"author"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+	PublishedAt  *time.Time `json:"publishedAt,omitempty"`
+}
+
+// WebhookComment is the comment of a comment.created event.
+type WebhookComment struct {
+	ID        int64     `json:"id"`
+	ParentID  int64     `json:"parentId,omitempty"`
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// WebhookRequest is one HTTP attempt of a delivery.
+type WebhookRequest struct {
+	URL    string
+	Header map[string]string
+	Body   []byte
+}
+
+// WebhookRepo is a webhook repo.
+type WebhookRepo interface {
+	CreateWebhook(ctx context.Context, w *Webhook) (*Webhook, error)
+	ListWebhooks(ctx context.Context, userID int64) ([]*Webhook, error)
+	CountWebhooks(ctx context.Context, userID int64) (int64, error)
+	// GetWebhook 不存在时返回 nil
+	GetWebhook(ctx context.Context, id int64) (*Webhook, error)
+	// DeleteWebhook 连同投递记录一起删除，队列里剩下的 id 在领取时发现记录不存在后出队
+	DeleteWebhook(ctx context.Context, id int64) error
+	// AddDeliveries 给订阅了 event 的 webhook（ownerID 的和全站的）各写入一条待投递记录并入队
+	AddDeliveries(ctx context.Context, ownerID int64, event string, payload []byte, now time.Time) error
+	// ClaimDeliveries 从队列领取到期的投递，lease 之内其他实例领取不到
+	ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]int64, error)
+	// GetDelivery 连同 webhook 的地址和密钥，记录不存在时返回 nil
+	GetDelivery(ctx context.Context, id int64) (*WebhookDelivery, error)
+	// SaveAttempt 写入一次尝试的结果：pending 按 NextAttemptAt 重新排队，delivered 出队，dead 出队并进入死信列表
+	SaveAttempt(ctx context.Context, d *WebhookDelivery) error
+	// Dequeue 把已经不需要投递的 id 移出队列
+	Dequeue(ctx context.Context, id int64) error
+	// RequeueStale 把早该投递却不在队列里的待投递记录（写库后入队失败）重新入队
+	RequeueStale(ctx context.Context, before time.Time, limit int) error
+	ListDeliveries(ctx context.Context, webhookID int64, status string, p *Page) ([]*WebhookDelivery, error)
+}
+
+// WebhookSender sends one attempt of a delivery.
+type WebhookSender interface {
+	// Send 返回响应的状态码；没有收到响应时返回 error
+	Send(ctx context.Context, req *WebhookRequest) (int, error)
+}
+
+// SignWebhook returns the X-Webhook-Signature of body sent at timestamp (unix seconds):
+// sha256=hex(HMAC-SHA256(secret, timestamp + "." + body)).
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook reports whether signature is the signature of body sent at timestamp.
+func VerifyWebhook(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(SignWebhook(secret, timestamp, body)), []byte(signature))
+}
+
+// randomHex 随机生成 n 字节的十六进制串
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// emitWebhook 把文章上的事件排队投递给订阅的 webhook；失败只记日志，不影响主流程
+func emitWebhook(ctx context.Context, hooks WebhookRepo, users RealWorldRepo, l *log.Helper, event string, art *Article, previousSlug string, comment *WebhookComment) {
+	author, err := users.FindByID(ctx, art.AuthorID)
+	if err != nil || author == nil {
+		l.WithContext(ctx).Warnf("find article author error: %v", err)
+		return
+	}
+	id, err := randomHex(16)
+	if err != nil {
+		l.WithContext(ctx).Warnf("generate event id error: %v", err)
+		return
+	}
+	now := time.Now()
+	if previousSlug == art.Slug {
+		previousSlug = ""
+	}
+	b, err := json.Marshal(&WebhookPayload{
+		ID:        id,
+		Event:     event,
+		CreatedAt: now,
+		Article: &WebhookArticle{
+			Slug:         art.Slug,
+			PreviousSlug: previousSlug,
+			Title:        art.Title,
+			Description:  art.Description,
+			Status:       art.Status,
+			Author:       author.UserName,
+			CreatedAt:    art.CreatedAt,
+			UpdatedAt:    art.UpdatedAt,
+			PublishedAt:  art.PublishedAt,
+		},
+		Comment: comment,
+	})
+	if err != nil {
+		l.WithContext(ctx).Warnf("encode %s webhook error: %v", event, err)
+		return
+	}
+	if err := hooks.AddDeliveries(ctx, art.AuthorID, event, b, now); err != nil {
+		l.WithContext(ctx).Warnf("enqueue %s webhook error: %v", event, err)
+	}
+}
+
+// emitStatusWebhook 文章状态变化后：变为公开发布时推送 published，从公开发布变为其他状态时推送 unpublished
+func emitStatusWebhook(ctx context.Context, hooks WebhookRepo, users RealWorldRepo, l *log.Helper, from string, art *Article) {
+	switch {
+	case art.Status == ArticleStatusPublished && from != ArticleStatusPublished:
+		emitWebhook(ctx, hooks, users, l, WebhookArticlePublished, art, "", nil)
+	case art.Status != ArticleStatusPublished && from == ArticleStatusPublished:
+		emitWebhook(ctx, hooks, users, l, WebhookArticleUnpublished, art, "", nil)
+	}
+}
+
+// WebhookUsecase is a webhook usecase.
+type WebhookUsecase struct {
+	repo        WebhookRepo
+	users       RealWorldRepo
+	sender      WebhookSender
+	interval    time.Duration
+	timeout     time.Duration
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	// 允许注册回环、内网地址，只用于本地开发和测试
+	allowPrivate bool
+	log          *log.Helper
+}
+
+// NewWebhookUsecase new a webhook usecase.
+func NewWebhookUsecase(repo WebhookRepo, users RealWorldRepo, sender WebhookSender, c *conf.Biz, logger log.Logger) *WebhookUsecase {
+	uc := &WebhookUsecase{
+		repo:        repo,
+		users:       users,
+		sender:      sender,
+		interval:    defaultWebhookInterval,
+		timeout:     defaultWebhookTimeout,
+		maxAttempts: defaultWebhookMaxAttempts,
+		backoff:     defaultWebhookBackoff,
+		maxBackoff:  defaultWebhookMaxBackoff,
+		log:         log.NewHelper(logger),
+	}
+	wc := c.GetWebhook()
+	if d := wc.GetInterval(); d != nil && d.AsDuration() > 0 {
+		uc.interval = d.AsDuration()
+	}
+	if d := wc.GetTimeout(); d != nil && d.AsDuration() > 0 {
+		uc.timeout = d.AsDuration()
+	}
+	if n := wc.GetMaxAttempts(); n > 0 {
+		uc.maxAttempts = int(n)
+	}
+	if d := wc.GetBackoff(); d != nil && d.AsDuration() > 0 {
+		uc.backoff = d.AsDuration()
+	}
+	if d := wc.GetMaxBackoff(); d != nil && d.AsDuration() > 0 {
+		uc.maxBackoff = d.AsDuration()
+	}
+	uc.allowPrivate = wc.GetAllowPrivateTargets()
+	return uc
+}
+
+// Interval returns how often the delivery queue is polled.
+func (uc *WebhookUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// CreateWebhook registers a webhook of myid. Only moderators may subscribe to events of every article.
+// A secret is generated when w.Secret is empty.
+func (uc *WebhookUsecase) CreateWebhook(ctx context.Context, myid int64, w *Webhook, events []string) (*Webhook, error) {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.BadRequest("webhook url must be an absolute http or https url", "")
+	}
+	if !uc.allowPrivate {
+		if err := checkWebhookTarget(ctx, u); err != nil {
+			return nil, err
+		}
+	}
+	seen := make(map[string]bool, len(events))
+	list := make([]string, 0, len(events))
+	for _, e := range events {
+		known := false
+		for _, k := range webhookEvents {
+			if e == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, errors.BadRequest("unknown webhook event: "+e, "")
+		}
+		if !seen[e] {
+			seen[e] = true
+			list = append(list, e)
+		}
+	}
+	// 订阅了全部事件的和不指定事件的一样，以后新增的事件也能收到
+	if len(list) == len(webhookEvents) {
+		list = nil
+	}
+	if w.Global {
+		user, err := uc.users.FindByID(ctx, myid)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || !user.Moderator {
+			return nil, errors.Forbidden("only moderators can subscribe to all events", "")
+		}
+	}
+	if len(w.Secret) > maxWebhookSecretLen {
+		return nil, errors.BadRequest(fmt.Sprintf("secret is longer than %d bytes", maxWebhookSecretLen), "")
+	}
+	n, err := uc.repo.CountWebhooks(ctx, myid)
+	if err != nil {
+		return nil, err
+	}
+	if n >= maxWebhooksPerUser {
+		return nil, errors.BadRequest(fmt.Sprintf("at most %d webhooks per user", maxWebhooksPerUser), "")
+	}
+	if w.Secret == "" {
+		if w.Secret, err = randomHex(32); err != nil {
+			return nil, err
+		}
+	}
+	w.UserID = myid
+	w.Events = strings.Join(list, ",")
+	return uc.repo.CreateWebhook(ctx, w)
+}
+
+// ListWebhooks returns the webhooks of myid.
+func (uc *WebhookUsecase) ListWebhooks(ctx context.Context, myid int64) ([]*Webhook, error) {
+	return uc.repo.ListWebhooks(ctx, myid)
+}
+
+// DeleteWebhook deletes a webhook of myid and drops its pending deliveries.
+func (uc *WebhookUsecase) DeleteWebhook(ctx context.Context, myid, id int64) error {
+	if _, err := uc.ownWebhook(ctx, myid, id); err != nil {
+		return err
+	}
+	return uc.repo.DeleteWebhook(ctx, id)
+}
+
+// ListDeliveries returns the newest deliveries of a webhook of myid, optionally only those with the given
+// status, and the next cursor.
+func (uc *WebhookUsecase) ListDeliveries(ctx context.Context, myid, id int64, status string, p *Page) ([]*WebhookDelivery, *PageCursor, error) {
+	switch status {
+	case "", WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryDead:
+	default:
+		return nil, nil, errors.BadRequest("unknown delivery status: "+status, "")
+	}
+	if _, err := uc.ownWebhook(ctx, myid, id); err != nil {
+		return nil, nil, err
+	}
+	p.normalize()
+	list, err := uc.repo.ListDeliveries(ctx, id, status, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, next := cutPage(list, p.Limit, func(d *WebhookDelivery) PageCursor {
+		return PageCursor{CreatedAt: d.CreatedAt, ID: d.ID}
+	})
+	return list, next, nil
+}
+
+// ownWebhook 别人的 webhook 和不存在的一样返回 not found
+func (uc *WebhookUsecase) ownWebhook(ctx context.Context, myid, id int64) (*Webhook, error) {
+	w, err := uc.repo.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil || w.UserID != myid {
+		return nil, ErrWebhookNotFound
+	}
+	return w, nil
+}
+
+// Deliver sends the deliveries that are due. Failed ones are retried with exponential backoff and moved to
+// the dead-letter list after the last attempt.
+// 多个实例可以同时执行：领取时加租约，同一投递同一时间只有一个实例在发
+func (uc *WebhookUsecase) Deliver(ctx context.Context) error {
+	now := time.Now()
+	lease := uc.timeout + webhookLeaseMargin
+	if err := uc.repo.RequeueStale(ctx, now.Add(-lease), webhookBatchSize); err != nil {
+		return err
+	}
+	ids, err := uc.repo.ClaimDeliveries(ctx, now, lease, webhookBatchSize)
+	if err != nil {
+		return err
+	}
+	sem := make(chan struct{}, webhookConcurrency)
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			uc.deliverOne(ctx, id)
+		}(id)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (uc *WebhookUsecase) deliverOne(ctx context.Context, id int64) {
+	d, err := uc.repo.GetDelivery(ctx, id)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("get webhook delivery %d error: %v", id, err)
+		return
+	}
+	// webhook 已删除或已经投递完成
+	if d == nil || d.Status != WebhookDeliveryPending {
+		if err := uc.repo.Dequeue(ctx, id); err != nil {
+			uc.log.WithContext(ctx).Warnf("dequeue webhook delivery %d error: %v", id, err)
+		}
+		return
+	}
+	now := time.Now()
+	body := []byte(d.Payload)
+	sendCtx, cancel := context.WithTimeout(ctx, uc.timeout)
+	status, err := uc.sender.Send(sendCtx, &WebhookRequest{
+		URL: d.URL,
+		Header: map[string]string{
+			"Content-Type":        "application/json",
+			"X-Webhook-Event":     d.Event,
+			"X-Webhook-Delivery":  strconv.FormatInt(d.ID, 10),
+			"X-Webhook-Timestamp": strconv.FormatInt(now.Unix(), 10),
+			"X-Webhook-Signature": SignWebhook(d.Secret, now.Unix(), body),
+		},
+		Body: body,
+	})
+	cancel()
+	if ctx.Err() != nil {
+		// 服务关闭打断的尝试不计数，租约到期后重新投递
+		return
+	}
+	d.Attempts++
+	d.ResponseStatus = status
+	switch {
+	case err == nil && status >= 200 && status < 300:
+		d.Status = WebhookDeliveryDelivered
+		d.LastError = ""
+		d.DeliveredAt = &now
+		d.NextAttemptAt = nil
+	default:
+		if err != nil {
+			d.LastError = err.Error()
+		} else {
+			d.LastError = fmt.Sprintf("unexpected response status %d", status)
+		}
+		if d.Attempts >= uc.maxAttempts {
+			d.Status = WebhookDeliveryDead
+			d.NextAttemptAt = nil
+		} else {
+			next := now.Add(uc.retryAfter(d.Attempts))
+			d.NextAttemptAt = &next
+		}
+	}
+	if err := uc.repo.SaveAttempt(ctx, d); err != nil {
+		uc.log.WithContext(ctx).Warnf("save webhook delivery %d error: %v", id, err)
+		return
+	}
+	if d.Status == WebhookDeliveryDead {
+		uc.log.WithContext(ctx).Warnf("webhook delivery %d gave up after %d attempts: %s", id, d.Attempts, d.LastError)
+	}
+}
+
+// retryAfter 第 attempts 次失败后的等待时间：backoff * 2^(attempts-1)，不超过 maxBackoff
+func (uc *WebhookUsecase) retryAfter(attempts int) time.Duration {
+	d := uc.backoff
+	for i := 1; i < attempts && d < uc.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > uc.maxBackoff {
+		d = uc.maxBackoff
+	}
+	return d
+}
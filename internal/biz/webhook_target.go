@@ -0,0 +1,58 @@
+package biz
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"net/url"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 除了 netip 能直接判断的回环、内网、链路本地等地址，再排除这些不该从服务端访问的网段
+var blockedWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // 本网络
+	netip.MustParsePrefix("100.64.0.0/10"), // 运营商级 NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF 协议分配
+	netip.MustParsePrefix("198.18.0.0/15"), // 基准测试
+	netip.MustParsePrefix("240.0.0.0/4"),   // 保留
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64，可能映射到内网 IPv4
+}
+
+// PublicWebhookAddr reports whether a webhook may be delivered to ip. Loopback, private, link-local,
+// unspecified, multicast and other non-public addresses are refused, so that webhooks cannot be used
+// to reach the internal network.
+func PublicWebhookAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, p := range blockedWebhookPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWebhookTarget 注册时解析 webhook 的主机名，任何一个地址不是公网地址都拒绝。
+// 解析结果之后可能变化（DNS rebinding），投递时连接前还会按实际连接的地址再检查一次
+func checkWebhookTarget(ctx context.Context, u *url.URL) error {
+	host := u.Hostname()
+	var addrs []netip.Addr
+	if ip, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{ip}
+	} else {
+		var err error
+		if addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host); err != nil || len(addrs) == 0 {
+			return errors.BadRequest("webhook host cannot be resolved", "")
+		}
+	}
+	for _, ip := range addrs {
+		if !PublicWebhookAddr(ip) {
+			return errors.BadRequest("webhook url must point to a public address", "")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+package biz_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/data"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memWebhookRepo 内存版的投递队列，只实现投递用到的方法
+type memWebhookRepo struct {
+	biz.WebhookRepo
+
+	mu         sync.Mutex
+	deliveries map[int64]*biz.WebhookDelivery
+	// 队列里的 id 和可以领取的时间
+	queue map[int64]time.Time
+	dead  []int64
+	count int64
+}
+
+func newMemWebhookRepo() *memWebhookRepo {
+	return &memWebhookRepo{
+		deliveries: make(map[int64]*biz.WebhookDelivery),
+		queue:      make(map[int64]time.Time),
+	}
+}
+
+func (r *memWebhookRepo) add(d *biz.WebhookDelivery) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d.Status = biz.WebhookDeliveryPending
+	r.deliveries[d.ID] = d
+	r.queue[d.ID] = time.Now()
+}
+
+// makeDue 让排队中的投递立即到期，省去真的等待退避时间
+func (r *memWebhookRepo) makeDue() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id := range r.queue {
+		r.queue[id] = time.Now()
+	}
+}
+
+func (r *memWebhookRepo) get(id int64) biz.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.deliveries[id]
+}
+
+func (r *memWebhookRepo) CountWebhooks(ctx context.Context, userID int64) (int64, error) {
+	return 0, nil
+}
+
+func (r *memWebhookRepo) CreateWebhook(ctx context.Context, w *biz.Webhook) (*biz.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.count++
+	w.ID = r.count
+	return w, nil
+}
+
+func (r *memWebhookRepo) RequeueStale(ctx context.Context, before time.Time, limit int) error {
+	return nil
+}
+
+func (r *memWebhookRepo) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []int64
+	for id, at := range r.queue {
+		if !at.After(now) && len(ids) < limit {
+			ids = append(ids, id)
+			r.queue[id] = now.Add(lease)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memWebhookRepo) GetDelivery(ctx context.Context, id int64) (*biz.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.deliveries[id]
+	if !ok {
+		return nil, nil
+	}
+	cp := *d
+	return &cp, nil
+}
+
+func (r *memWebhookRepo) SaveAttempt(ctx context.Context, d *biz.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *d
+	r.deliveries[d.ID] = &cp
+	switch d.Status {
+	case biz.WebhookDeliveryPending:
+		r.queue[d.ID] = *d.NextAttemptAt
+	case biz.WebhookDeliveryDead:
+		delete(r.queue, d.ID)
+		r.dead = append(r.dead, d.ID)
+	default:
+		delete(r.queue, d.ID)
+	}
+	return nil
+}
+
+func (r *memWebhookRepo) Dequeue(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.queue, id)
+	return nil
+}
+
+// receiver 按 statuses 依次返回状态码，用完后一直返回最后一个；校验每个请求的签名
+type receiver struct {
+	t        *testing.T
+	secret   string
+	statuses []int
+
+	mu       sync.Mutex
+	requests int
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	ts, err := strconv.ParseInt(r.Header.Get("X-Webhook-Timestamp"), 10, 64)
+	if err != nil || !biz.VerifyWebhook(rc.secret, ts, body, r.Header.Get("X-Webhook-Signature")) {
+		rc.t.Errorf("bad signature %q at %q", r.Header.Get("X-Webhook-Signature"), r.Header.Get("X-Webhook-Timestamp"))
+	}
+	rc.mu.Lock()
+	status := rc.statuses[len(rc.statuses)-1]
+	if rc.requests < len(rc.statuses) {
+		status = rc.statuses[rc.requests]
+	}
+	rc.requests++
+	rc.mu.Unlock()
+	w.WriteHeader(status)
+}
+
+func (rc *receiver) count() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.requests
+}
+
+func webhookConf(maxAttempts int32, backoff, maxBackoff time.Duration) *conf.Biz {
+	return &conf.Biz{Webhook: &conf.Biz_Webhook{
+		Timeout:             durationpb.New(5 * time.Second),
+		MaxAttempts:         maxAttempts,
+		Backoff:             durationpb.New(backoff),
+		MaxBackoff:          durationpb.New(maxBackoff),
+		AllowPrivateTargets: true,
+	}}
+}
+
+// deliverOnce 执行一轮投递，返回这一轮开始的时间
+func deliverOnce(t *testing.T, uc *biz.WebhookUsecase) time.Time {
+	t.Helper()
+	start := time.Now()
+	if err := uc.Deliver(context.Background()); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	return start
+}
+
+// checkRetryAfter 检查下次尝试的时间是在 start 之后 want 左右
+func checkRetryAfter(t *testing.T, d biz.WebhookDelivery, start time.Time, want time.Duration) {
+	t.Helper()
+	if d.NextAttemptAt == nil {
+		t.Fatalf("attempt %d: no next attempt scheduled", d.Attempts)
+	}
+	got := d.NextAttemptAt.Sub(start)
+	if got < want || got > want+2*time.Second {
+		t.Errorf("attempt %d: retry after %v, want %v", d.Attempts, got, want)
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	body := []byte(`{"event":"article.published"}`)
+	sig := biz.SignWebhook("secret", 1700000000, body)
+	if len(sig) != len("sha256=")+64 || sig[:7] != "sha256=" {
+		t.Fatalf("signature %q is not sha256=<hex>", sig)
+	}
+	if !biz.VerifyWebhook("secret", 1700000000, body, sig) {
+		t.Error("signature does not verify")
+	}
+	if biz.VerifyWebhook("other", 1700000000, body, sig) {
+		t.Error("signature verifies with another secret")
+	}
+	if biz.VerifyWebhook("secret", 1700000001, body, sig) {
+		t.Error("signature verifies at another timestamp")
+	}
+	if biz.VerifyWebhook("secret", 1700000000, []byte(`{}`), sig) {
+		t.Error("signature verifies another body")
+	}
+}
+
+func TestDeliverRetriesWithBackoff(t *testing.T) {
+	rc := &receiver{t: t, secret: "s3cret", statuses: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusNoContent}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	c := webhookConf(5, 10*time.Second, time.Hour)
+	repo := newMemWebhookRepo()
+	uc := biz.NewWebhookUsecase(repo, nil, data.NewWebhookSender(c), c, log.DefaultLogger)
+	repo.add(&biz.WebhookDelivery{ID: 1, Event: biz.WebhookArticlePublished, Payload: `{"id":"1"}`, URL: srv.URL, Secret: rc.secret})
+
+	start := deliverOnce(t, uc)
+	d := repo.get(1)
+	if d.Status != biz.WebhookDeliveryPending || d.Attempts != 1 || d.ResponseStatus != http.StatusInternalServerError {
+		t.Fatalf("after 1st attempt: status %s, attempts %d, response %d", d.Status, d.Attempts, d.ResponseStatus)
+	}
+	if d.LastError == "" {
+		t.Error("after 1st attempt: no error recorded")
+	}
+	checkRetryAfter(t, d, start, 10*time.Second)
+
+	// 没到重试时间不会再发
+	deliverOnce(t, uc)
+	if n := rc.count(); n != 1 {
+		t.Fatalf("sent %d requests before the retry was due, want 1", n)
+	}
+
+	repo.makeDue()
+	start = deliverOnce(t, uc)
+	d = repo.get(1)
+	if d.Status != biz.WebhookDeliveryPending || d.Attempts != 2 {
+		t.Fatalf("after 2nd attempt: status %s, attempts %d", d.Status, d.Attempts)
+	}
+	checkRetryAfter(t, d, start, 20*time.Second)
+
+	repo.makeDue()
+	deliverOnce(t, uc)
+	d = repo.get(1)
+	if d.Status != biz.WebhookDeliveryDelivered || d.Attempts != 3 || d.ResponseStatus != http.StatusNoContent {
+		t.Fatalf("after 3rd attempt: status %s, attempts %d, response %d", d.Status, d.Attempts, d.ResponseStatus)
+	}
+	if d.DeliveredAt == nil || d.NextAttemptAt != nil || d.LastError != "" {
+		t.Errorf("delivered: deliveredAt %v, nextAttemptAt %v, lastError %q", d.DeliveredAt, d.NextAttemptAt, d.LastError)
+	}
+
+	repo.makeDue()
+	deliverOnce(t, uc)
+	if n := rc.count(); n != 3 {
+		t.Errorf("sent %d requests, want 3", n)
+	}
+}
+
+func TestDeliverDeadLetter(t *testing.T) {
+	rc := &receiver{t: t, secret: "s3cret", statuses: []int{http.StatusServiceUnavailable}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	c := webhookConf(3, 10*time.Second, 15*time.Second)
+	repo := newMemWebhookRepo()
+	uc := biz.NewWebhookUsecase(repo, nil, data.NewWebhookSender(c), c, log.DefaultLogger)
+	repo.add(&biz.WebhookDelivery{ID: 7, Event: biz.WebhookCommentCreated, Payload: `{"id":"7"}`, URL: srv.URL, Secret: rc.secret})
+
+	start := deliverOnce(t, uc)
+	checkRetryAfter(t, repo.get(7), start, 10*time.Second)
+	repo.makeDue()
+	start = deliverOnce(t, uc)
+	// 翻倍后超过上限，按上限等待
+	checkRetryAfter(t, repo.get(7), start, 15*time.Second)
+	repo.makeDue()
+	deliverOnce(t, uc)
+
+	d := repo.get(7)
+	if d.Status != biz.WebhookDeliveryDead || d.Attempts != 3 || d.NextAttemptAt != nil {
+		t.Fatalf("after last attempt: status %s, attempts %d, nextAttemptAt %v", d.Status, d.Attempts, d.NextAttemptAt)
+	}
+	if len(repo.dead) != 1 || repo.dead[0] != 7 {
+		t.Errorf("dead letters %v, want [7]", repo.dead)
+	}
+
+	repo.makeDue()
+	deliverOnce(t, uc)
+	if n := rc.count(); n != 3 {
+		t.Errorf("sent %d requests, want 3", n)
+	}
+}
+
+func TestDeliverDoesNotFollowRedirects(t *testing.T) {
+	target := &receiver{t: t, secret: "s3cret", statuses: []int{http.StatusNoContent}}
+	dst := httptest.NewServer(target)
+	defer dst.Close()
+	src := httptest.NewServer(http.RedirectHandler(dst.URL, http.StatusFound))
+	defer src.Close()
+
+	c := webhookConf(3, 10*time.Second, time.Hour)
+	repo := newMemWebhookRepo()
+	uc := biz.NewWebhookUsecase(repo, nil, data.NewWebhookSender(c), c, log.DefaultLogger)
+	repo.add(&biz.WebhookDelivery{ID: 1, Event: biz.WebhookArticleUpdated, Payload: `{}`, URL: src.URL, Secret: "s3cret"})
+
+	deliverOnce(t, uc)
+	d := repo.get(1)
+	if d.Status != biz.WebhookDeliveryPending || d.ResponseStatus != http.StatusFound {
+		t.Errorf("status %s, response %d, want pending after a 302", d.Status, d.ResponseStatus)
+	}
+	if n := target.count(); n != 0 {
+		t.Errorf("redirect was followed %d times", n)
+	}
+}
+
+func TestCreateWebhookRejectsPrivateTargets(t *testing.T) {
+	uc := biz.NewWebhookUsecase(newMemWebhookRepo(), nil, nil, &conf.Biz{}, log.DefaultLogger)
+	for _, u := range []string{
+		"http://127.0.0.1:6379/",
+		"http://localhost/hook",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.5/hook",
+		"http://[::1]:8080/",
+		"http://[::ffff:192.168.1.1]/",
+		"http://0.0.0.0/",
+	} {
+		_, err := uc.CreateWebhook(context.Background(), 1, &biz.Webhook{URL: u}, nil)
+		if !errors.IsBadRequest(err) {
+			t.Errorf("%s: got %v, want bad request", u, err)
+		}
+	}
+
+	allowed := biz.NewWebhookUsecase(newMemWebhookRepo(), nil, nil, webhookConf(3, time.Second, time.Second), log.DefaultLogger)
+	if _, err := allowed.CreateWebhook(context.Background(), 1, &biz.Webhook{URL: "http://127.0.0.1:8080/"}, nil); err != nil {
+		t.Errorf("allow_private_targets: %v", err)
+	}
+}
+
+func TestPublicWebhookAddr(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.0.1":      false,
+		"169.254.169.254":  false,
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"::":               false,
+		"::1":              false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"::ffff:127.0.0.1": false,
+		"224.0.0.1":        false,
+	} {
+		if got := biz.PublicWebhookAddr(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("PublicWebhookAddr(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
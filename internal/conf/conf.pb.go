@@ -27,6 +27,7 @@ type Bootstrap struct {
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	Biz           *Biz                   `protobuf:"bytes,4,opt,name=biz,proto3" json:"biz,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +83,13 @@ func (x *Bootstrap) GetAuth() *Auth {
 	return nil
 }
 
+func (x *Bootstrap) GetBiz() *Biz {
+	if x != nil {
+		return x.Biz
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -189,6 +197,7 @@ func (x *Data) GetRedis() *Data_Redis {
 type Auth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	CursorSecret  string                 `protobuf:"bytes,2,opt,name=cursor_secret,json=cursorSecret,proto3" json:"cursor_secret,omitempty"` // 分页 cursor 签名密钥的来源，为空时从 jwt_secret 派生
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -230,6 +239,137 @@ func (x *Auth) GetJwtSecret() string {
 	return ""
 }
 
+func (x *Auth) GetCursorSecret() string {
+	if x != nil {
+		return x.CursorSecret
+	}
+	return ""
+}
+
+type Biz struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestion    *Biz_Suggestion        `protobuf:"bytes,1,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	Scheduler     *Biz_Scheduler         `protobuf:"bytes,2,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	Concurrency   *Biz_Concurrency       `protobuf:"bytes,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Trending      *Biz_Trending          `protobuf:"bytes,4,opt,name=trending,proto3" json:"trending,omitempty"`
+	Related       *Biz_Related           `protobuf:"bytes,5,opt,name=related,proto3" json:"related,omitempty"`
+	Comment       *Biz_Comment           `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Reaction      *Biz_Reaction          `protobuf:"bytes,7,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Live          *Biz_Live              `protobuf:"bytes,8,opt,name=live,proto3" json:"live,omitempty"`
+	Presence      *Biz_Presence          `protobuf:"bytes,9,opt,name=presence,proto3" json:"presence,omitempty"`
+	Webhook       *Biz_Webhook           `protobuf:"bytes,10,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Outbox        *Biz_Outbox            `protobuf:"bytes,11,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz) Reset() {
+	*x = Biz{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz) ProtoMessage() {}
+
+func (x *Biz) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz.ProtoReflect.Descriptor instead.
+func (*Biz) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Biz) GetSuggestion() *Biz_Suggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+func (x *Biz) GetScheduler() *Biz_Scheduler {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *Biz) GetConcurrency() *Biz_Concurrency {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *Biz) GetTrending() *Biz_Trending {
+	if x != nil {
+		return x.Trending
+	}
+	return nil
+}
+
+func (x *Biz) GetRelated() *Biz_Related {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+func (x *Biz) GetComment() *Biz_Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *Biz) GetReaction() *Biz_Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *Biz) GetLive() *Biz_Live {
+	if x != nil {
+		return x.Live
+	}
+	return nil
+}
+
+func (x *Biz) GetPresence() *Biz_Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *Biz) GetWebhook() *Biz_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *Biz) GetOutbox() *Biz_Outbox {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -241,7 +381,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -253,7 +393,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +441,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +453,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +500,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +512,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +555,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +567,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,16 +618,614 @@ func (x *Data_Redis) GetPassword() string {
 	return ""
 }
 
+type Biz_Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"` // 推荐列表的预计算周期
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`      // 每个用户缓存的推荐人数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Suggestion) Reset() {
+	*x = Biz_Suggestion{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Suggestion) ProtoMessage() {}
+
+func (x *Biz_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Suggestion.ProtoReflect.Descriptor instead.
+func (*Biz_Suggestion) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Biz_Suggestion) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Biz_Suggestion) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Biz_Scheduler struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"` // 扫描到期定时发布文章的周期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Scheduler) Reset() {
+	*x = Biz_Scheduler{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Scheduler) ProtoMessage() {}
+
+func (x *Biz_Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Scheduler.ProtoReflect.Descriptor instead.
+func (*Biz_Scheduler) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Biz_Scheduler) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type Biz_Concurrency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为 true 时允许不带 If-Match / version 的更新，兼容老客户端
+	AllowUnconditional bool `protobuf:"varint,1,opt,name=allow_unconditional,json=allowUnconditional,proto3" json:"allow_unconditional,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Biz_Concurrency) Reset() {
+	*x = Biz_Concurrency{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Concurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Concurrency) ProtoMessage() {}
+
+func (x *Biz_Concurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Concurrency.ProtoReflect.Descriptor instead.
+func (*Biz_Concurrency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *Biz_Concurrency) GetAllowUnconditional() bool {
+	if x != nil {
+		return x.AllowUnconditional
+	}
+	return false
+}
+
+type Biz_Trending struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`                                    // 只统计这段时间内的阅读、收藏和评论
+	HalfLife      *durationpb.Duration   `protobuf:"bytes,2,opt,name=half_life,json=halfLife,proto3" json:"half_life,omitempty"`                // 热度衰减一半所需的时间
+	FlushInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"` // 阅读数从 Redis 落库的周期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Trending) Reset() {
+	*x = Biz_Trending{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Trending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Trending) ProtoMessage() {}
+
+func (x *Biz_Trending) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Trending.ProtoReflect.Descriptor instead.
+func (*Biz_Trending) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *Biz_Trending) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Biz_Trending) GetHalfLife() *durationpb.Duration {
+	if x != nil {
+		return x.HalfLife
+	}
+	return nil
+}
+
+func (x *Biz_Trending) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+type Biz_Related struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CacheTtl      *durationpb.Duration   `protobuf:"bytes,1,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"` // 相关文章缓存时间，标签变化时提前失效
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Related) Reset() {
+	*x = Biz_Related{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Related) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Related) ProtoMessage() {}
+
+func (x *Biz_Related) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Related.ProtoReflect.Descriptor instead.
+func (*Biz_Related) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *Biz_Related) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+type Biz_Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxDepth      int32                  `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`      // 回复最多嵌套的层数，顶层评论为第 0 层
+	EditWindow    *durationpb.Duration   `protobuf:"bytes,2,opt,name=edit_window,json=editWindow,proto3" json:"edit_window,omitempty"` // 发表后允许作者修改的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Comment) Reset() {
+	*x = Biz_Comment{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Comment) ProtoMessage() {}
+
+func (x *Biz_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Comment.ProtoReflect.Descriptor instead.
+func (*Biz_Comment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *Biz_Comment) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *Biz_Comment) GetEditWindow() *durationpb.Duration {
+	if x != nil {
+		return x.EditWindow
+	}
+	return nil
+}
+
+type Biz_Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"` // 允许的表态，回复中按这个顺序列出
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Reaction) Reset() {
+	*x = Biz_Reaction{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Reaction) ProtoMessage() {}
+
+func (x *Biz_Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Reaction.ProtoReflect.Descriptor instead.
+func (*Biz_Reaction) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Biz_Reaction) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type Biz_Live struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Heartbeat     *durationpb.Duration   `protobuf:"bytes,1,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"` // 实时推送连接空闲时的心跳间隔
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Live) Reset() {
+	*x = Biz_Live{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Live) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Live) ProtoMessage() {}
+
+func (x *Biz_Live) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Live.ProtoReflect.Descriptor instead.
+func (*Biz_Live) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *Biz_Live) GetHeartbeat() *durationpb.Duration {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+type Biz_Presence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnlineTtl     *durationpb.Duration   `protobuf:"bytes,1,opt,name=online_ttl,json=onlineTtl,proto3" json:"online_ttl,omitempty"` // 最后一次活动之后仍算在线的时长
+	Refresh       *durationpb.Duration   `protobuf:"bytes,2,opt,name=refresh,proto3" json:"refresh,omitempty"`                      // 这段时间内的活动不重复刷新在线状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Presence) Reset() {
+	*x = Biz_Presence{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Presence) ProtoMessage() {}
+
+func (x *Biz_Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Presence.ProtoReflect.Descriptor instead.
+func (*Biz_Presence) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *Biz_Presence) GetOnlineTtl() *durationpb.Duration {
+	if x != nil {
+		return x.OnlineTtl
+	}
+	return nil
+}
+
+func (x *Biz_Presence) GetRefresh() *durationpb.Duration {
+	if x != nil {
+		return x.Refresh
+	}
+	return nil
+}
+
+type Biz_Webhook struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Interval            *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                                                     // 投递队列的轮询间隔
+	Timeout             *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                       // 单次投递的超时
+	MaxAttempts         int32                  `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                           // 超过后移入死信列表
+	Backoff             *durationpb.Duration   `protobuf:"bytes,4,opt,name=backoff,proto3" json:"backoff,omitempty"`                                                       // 第一次重试的等待时间，之后每次翻倍
+	MaxBackoff          *durationpb.Duration   `protobuf:"bytes,5,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`                               // 重试等待时间的上限
+	AllowPrivateTargets bool                   `protobuf:"varint,6,opt,name=allow_private_targets,json=allowPrivateTargets,proto3" json:"allow_private_targets,omitempty"` // 允许投递到回环、内网等地址，只在本地开发和测试时打开
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Biz_Webhook) Reset() {
+	*x = Biz_Webhook{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Webhook) ProtoMessage() {}
+
+func (x *Biz_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Webhook.ProtoReflect.Descriptor instead.
+func (*Biz_Webhook) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 9}
+}
+
+func (x *Biz_Webhook) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Biz_Webhook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Biz_Webhook) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Biz_Webhook) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+func (x *Biz_Webhook) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *Biz_Webhook) GetAllowPrivateTargets() bool {
+	if x != nil {
+		return x.AllowPrivateTargets
+	}
+	return false
+}
+
+type Biz_Outbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                                // 中继轮询 outbox 的间隔
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`            // 每批读取的事件数
+	Retention     *durationpb.Duration   `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"`                              // 已发布的事件保留多久
+	Publisher     string                 `protobuf:"bytes,4,opt,name=publisher,proto3" json:"publisher,omitempty"`                              // redis（默认，发布到 Redis Stream）或 memory（只在进程内保存，用于测试）
+	StreamMaxLen  int64                  `protobuf:"varint,5,opt,name=stream_max_len,json=streamMaxLen,proto3" json:"stream_max_len,omitempty"` // 每个 Redis Stream 大约保留的事件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Biz_Outbox) Reset() {
+	*x = Biz_Outbox{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Biz_Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Biz_Outbox) ProtoMessage() {}
+
+func (x *Biz_Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Biz_Outbox.ProtoReflect.Descriptor instead.
+func (*Biz_Outbox) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 10}
+}
+
+func (x *Biz_Outbox) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Biz_Outbox) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Biz_Outbox) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Biz_Outbox) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *Biz_Outbox) GetStreamMaxLen() int64 {
+	if x != nil {
+		return x.StreamMaxLen
+	}
+	return 0
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x83\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xa6\x01\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12$\n" +
-	"\x04auth\x18\x03 \x01(\v2\x10.kratos.api.AuthR\x04auth\"\xb8\x02\n" +
+	"\x04auth\x18\x03 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12!\n" +
+	"\x03biz\x18\x04 \x01(\v2\x0f.kratos.api.BizR\x03biz\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -510,10 +1248,67 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1a\n" +
-	"\bpassword\x18\x05 \x01(\tR\bpassword\"%\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\"J\n" +
 	"\x04Auth\x12\x1d\n" +
 	"\n" +
-	"jwt_secret\x18\x01 \x01(\tR\tjwtSecretB%Z#kratos-realworld/internal/conf;confb\x06proto3"
+	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x12#\n" +
+	"\rcursor_secret\x18\x02 \x01(\tR\fcursorSecret\"\x8a\x0f\n" +
+	"\x03Biz\x12:\n" +
+	"\n" +
+	"suggestion\x18\x01 \x01(\v2\x1a.kratos.api.Biz.SuggestionR\n" +
+	"suggestion\x127\n" +
+	"\tscheduler\x18\x02 \x01(\v2\x19.kratos.api.Biz.SchedulerR\tscheduler\x12=\n" +
+	"\vconcurrency\x18\x03 \x01(\v2\x1b.kratos.api.Biz.ConcurrencyR\vconcurrency\x124\n" +
+	"\btrending\x18\x04 \x01(\v2\x18.kratos.api.Biz.TrendingR\btrending\x121\n" +
+	"\arelated\x18\x05 \x01(\v2\x17.kratos.api.Biz.RelatedR\arelated\x121\n" +
+	"\acomment\x18\x06 \x01(\v2\x17.kratos.api.Biz.CommentR\acomment\x124\n" +
+	"\breaction\x18\a \x01(\v2\x18.kratos.api.Biz.ReactionR\breaction\x12(\n" +
+	"\x04live\x18\b \x01(\v2\x14.kratos.api.Biz.LiveR\x04live\x124\n" +
+	"\bpresence\x18\t \x01(\v2\x18.kratos.api.Biz.PresenceR\bpresence\x121\n" +
+	"\awebhook\x18\n" +
+	" \x01(\v2\x17.kratos.api.Biz.WebhookR\awebhook\x12.\n" +
+	"\x06outbox\x18\v \x01(\v2\x16.kratos.api.Biz.OutboxR\x06outbox\x1aY\n" +
+	"\n" +
+	"Suggestion\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x1aB\n" +
+	"\tScheduler\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x1a>\n" +
+	"\vConcurrency\x12/\n" +
+	"\x13allow_unconditional\x18\x01 \x01(\bR\x12allowUnconditional\x1a\xb7\x01\n" +
+	"\bTrending\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x126\n" +
+	"\thalf_life\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bhalfLife\x12@\n" +
+	"\x0eflush_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x1aA\n" +
+	"\aRelated\x126\n" +
+	"\tcache_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\x1ab\n" +
+	"\aComment\x12\x1b\n" +
+	"\tmax_depth\x18\x01 \x01(\x05R\bmaxDepth\x12:\n" +
+	"\vedit_window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"editWindow\x1a \n" +
+	"\bReaction\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x1a?\n" +
+	"\x04Live\x127\n" +
+	"\theartbeat\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\theartbeat\x1ay\n" +
+	"\bPresence\x128\n" +
+	"\n" +
+	"online_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tonlineTtl\x123\n" +
+	"\arefresh\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\arefresh\x1a\xbd\x02\n" +
+	"\aWebhook\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x123\n" +
+	"\abackoff\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\abackoff\x12:\n" +
+	"\vmax_backoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x122\n" +
+	"\x15allow_private_targets\x18\x06 \x01(\bR\x13allowPrivateTargets\x1a\xdb\x01\n" +
+	"\x06Outbox\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x127\n" +
+	"\tretention\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12\x1c\n" +
+	"\tpublisher\x18\x04 \x01(\tR\tpublisher\x12$\n" +
+	"\x0estream_max_len\x18\x05 \x01(\x03R\fstreamMaxLenB%Z#kratos-realworld/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -527,35 +1322,75 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
 	(*Data)(nil),                // 2: kratos.api.Data
 	(*Auth)(nil),                // 3: kratos.api.Auth
-	(*Server_HTTP)(nil),         // 4: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 5: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 6: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 7: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 8: google.protobuf.Duration
+	(*Biz)(nil),                 // 4: kratos.api.Biz
+	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
+	(*Biz_Suggestion)(nil),      // 9: kratos.api.Biz.Suggestion
+	(*Biz_Scheduler)(nil),       // 10: kratos.api.Biz.Scheduler
+	(*Biz_Concurrency)(nil),     // 11: kratos.api.Biz.Concurrency
+	(*Biz_Trending)(nil),        // 12: kratos.api.Biz.Trending
+	(*Biz_Related)(nil),         // 13: kratos.api.Biz.Related
+	(*Biz_Comment)(nil),         // 14: kratos.api.Biz.Comment
+	(*Biz_Reaction)(nil),        // 15: kratos.api.Biz.Reaction
+	(*Biz_Live)(nil),            // 16: kratos.api.Biz.Live
+	(*Biz_Presence)(nil),        // 17: kratos.api.Biz.Presence
+	(*Biz_Webhook)(nil),         // 18: kratos.api.Biz.Webhook
+	(*Biz_Outbox)(nil),          // 19: kratos.api.Biz.Outbox
+	(*durationpb.Duration)(nil), // 20: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
-	4,  // 3: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	5,  // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	6,  // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	7,  // 6: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	8,  // 7: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	8,  // 8: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	8,  // 9: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	8,  // 10: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 3: kratos.api.Bootstrap.biz:type_name -> kratos.api.Biz
+	5,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	9,  // 8: kratos.api.Biz.suggestion:type_name -> kratos.api.Biz.Suggestion
+	10, // 9: kratos.api.Biz.scheduler:type_name -> kratos.api.Biz.Scheduler
+	11, // 10: kratos.api.Biz.concurrency:type_name -> kratos.api.Biz.Concurrency
+	12, // 11: kratos.api.Biz.trending:type_name -> kratos.api.Biz.Trending
+	13, // 12: kratos.api.Biz.related:type_name -> kratos.api.Biz.Related
+	14, // 13: kratos.api.Biz.comment:type_name -> kratos.api.Biz.Comment
+	15, // 14: kratos.api.Biz.reaction:type_name -> kratos.api.Biz.Reaction
+	16, // 15: kratos.api.Biz.live:type_name -> kratos.api.Biz.Live
+	17, // 16: kratos.api.Biz.presence:type_name -> kratos.api.Biz.Presence
+	18, // 17: kratos.api.Biz.webhook:type_name -> kratos.api.Biz.Webhook
+	19, // 18: kratos.api.Biz.outbox:type_name -> kratos.api.Biz.Outbox
+	20, // 19: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	20, // 20: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	20, // 21: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	20, // 22: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	20, // 23: kratos.api.Biz.Suggestion.interval:type_name -> google.protobuf.Duration
+	20, // 24: kratos.api.Biz.Scheduler.interval:type_name -> google.protobuf.Duration
+	20, // 25: kratos.api.Biz.Trending.window:type_name -> google.protobuf.Duration
+	20, // 26: kratos.api.Biz.Trending.half_life:type_name -> google.protobuf.Duration
+	20, // 27: kratos.api.Biz.Trending.flush_interval:type_name -> google.protobuf.Duration
+	20, // 28: kratos.api.Biz.Related.cache_ttl:type_name -> google.protobuf.Duration
+	20, // 29: kratos.api.Biz.Comment.edit_window:type_name -> google.protobuf.Duration
+	20, // 30: kratos.api.Biz.Live.heartbeat:type_name -> google.protobuf.Duration
+	20, // 31: kratos.api.Biz.Presence.online_ttl:type_name -> google.protobuf.Duration
+	20, // 32: kratos.api.Biz.Presence.refresh:type_name -> google.protobuf.Duration
+	20, // 33: kratos.api.Biz.Webhook.interval:type_name -> google.protobuf.Duration
+	20, // 34: kratos.api.Biz.Webhook.timeout:type_name -> google.protobuf.Duration
+	20, // 35: kratos.api.Biz.Webhook.backoff:type_name -> google.protobuf.Duration
+	20, // 36: kratos.api.Biz.Webhook.max_backoff:type_name -> google.protobuf.Duration
+	20, // 37: kratos.api.Biz.Outbox.interval:type_name -> google.protobuf.Duration
+	20, // 38: kratos.api.Biz.Outbox.retention:type_name -> google.protobuf.Duration
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -569,7 +1404,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// articleViewColumns 文章列表的公共列，两个参数都是当前用户 id
+const articleViewColumns = `a.id, a.slug, a.title, a.description, a.body, a.author_id, a.created_at, a.updated_at,
+	a.status, a.published_at, a.scheduled_at, a.version, a.word_count, a.reading_minutes, a.toc,
+	a.views_count,
+	u.username AS author_name, COALESCE(u.bio, '') AS author_bio, COALESCE(u.image, '') AS author_image,
+	EXISTS (SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = u.id) AS following,
+	EXISTS (SELECT 1 FROM favorites fav WHERE fav.user_id = ? AND fav.article_id = a.id) AS favorited,
+	(SELECT COUNT(*) FROM favorites fav WHERE fav.article_id = a.id) AS favorites_count`
+
+// applyPage 按 (created_at, id) 倒序分页，多取一条留给 biz 层判断是否还有下一页
+func applyPage(db *gorm.DB, p *biz.Page, createdCol, idCol string) *gorm.DB {
+	if p.After != nil {
+		db = db.Where(fmt.Sprintf("(%s, %s) < (?, ?)", createdCol, idCol), p.After.CreatedAt, p.After.ID)
+	}
+	db = db.Order(createdCol + " DESC").Order(idCol + " DESC").Limit(p.Limit + 1)
+	if p.Offset > 0 {
+		db = db.Offset(p.Offset)
+	}
+	return db
+}
+
+// listArticleViews 统计总数并查询一页文章，按 (sortCol, id) 倒序，scope 负责拼接过滤条件
+func (r *RealWorldRepo) listArticleViews(ctx context.Context, myid int64, p *biz.Page, sortCol string, scope func(*gorm.DB) *gorm.DB) ([]*biz.ArticleView, int64, error) {
+	base := func() *gorm.DB {
+		return r.data.DB.WithContext(ctx).
+			Table("articles a").
+			Joins("JOIN users u ON u.id = a.author_id").
+			Scopes(scope)
+	}
+
+	var total int64
+	if err := base().Count(&total).Error; err != nil {
+		r.log.Errorf("count articles error: %v", err)
+		return nil, 0, err
+	}
+
+	var list []*biz.ArticleView
+	if err := applyPage(base().Select(articleViewColumns, myid, myid), p, sortCol, "a.id").
+		Scan(&list).Error; err != nil {
+		r.log.Errorf("list articles error: %v", err)
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(list))
+	for _, a := range list {
+		ids = append(ids, a.ID)
+	}
+	tags, err := loadTagLists(ctx, r.data.DB, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, a := range list {
+		a.TagList = tags[a.ID]
+	}
+	return list, total, nil
+}
+
+// ListArticles 按发布时间排序，先写好后发布的草稿排在前面
+func (r *RealWorldRepo) ListArticles(ctx context.Context, myid int64, f *biz.ArticleFilter, p *biz.Page) ([]*biz.ArticleView, int64, error) {
+	return r.listArticleViews(ctx, myid, p, "a.published_at", func(db *gorm.DB) *gorm.DB {
+		db = db.Where("a.status = ?", biz.ArticleStatusPublished)
+		if f.Tag != "" {
+			db = db.Where(`EXISTS (SELECT 1 FROM article_tags at JOIN tags t ON t.id = at.tag_id
+				WHERE at.article_id = a.id AND t.name = ?)`, f.Tag)
+		}
+		if f.Author != "" {
+			db = db.Where("u.username = ?", f.Author)
+		}
+		if f.Favorited != "" {
+			db = db.Where(`EXISTS (SELECT 1 FROM favorites fav JOIN users fu ON fu.id = fav.user_id
+				WHERE fav.article_id = a.id AND fu.username = ?)`, f.Favorited)
+		}
+		return db
+	})
+}
+
+func (r *RealWorldRepo) FeedArticles(ctx context.Context, myid int64, p *biz.Page) ([]*biz.ArticleView, int64, error) {
+	return r.listArticleViews(ctx, myid, p, "a.published_at", func(db *gorm.DB) *gorm.DB {
+		return db.Where("a.status = ?", biz.ArticleStatusPublished).
+			Where("a.author_id IN (SELECT followee_id FROM follows WHERE follower_id = ?)", myid)
+	})
+}
+
+func (r *RealWorldRepo) ListDrafts(ctx context.Context, myid int64, p *biz.Page) ([]*biz.ArticleView, int64, error) {
+	return r.listArticleViews(ctx, myid, p, "a.created_at", func(db *gorm.DB) *gorm.DB {
+		return db.Where("a.status = ?", biz.ArticleStatusDraft).Where("a.author_id = ?", myid)
+	})
+}
+
+func (r *RealWorldRepo) GetArticleView(ctx context.Context, myid int64, slug string) (*biz.ArticleView, error) {
+	var art biz.ArticleView
+	res := r.data.DB.WithContext(ctx).
+		Table("articles a").
+		Joins("JOIN users u ON u.id = a.author_id").
+		Select(articleViewColumns, myid, myid).
+		Where("a.slug = ?", slug).
+		Take(&art)
+	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrArticleNotFound
+	}
+	if res.Error != nil {
+		r.log.Errorf("GetArticleView error: %v", res.Error)
+		return nil, res.Error
+	}
+	tags, err := loadTagLists(ctx, r.data.DB, []int64{art.ID})
+	if err != nil {
+		return nil, err
+	}
+	art.TagList = tags[art.ID]
+	return &art, nil
+}
+
+func (r *RealWorldRepo) AddFavorite(ctx context.Context, userID, articleID int64) error {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 重复收藏不报错，也不产生事件
+		res := tx.Exec(
+			"INSERT INTO favorites (user_id, article_id, created_at) VALUES (?, ?, NOW()) ON CONFLICT DO NOTHING",
+			userID, articleID)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return favoriteEvent(tx, biz.EventArticleFavorited, userID, articleID)
+	})
+	if err != nil {
+		r.log.Errorf("AddFavorite error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *RealWorldRepo) RemoveFavorite(ctx context.Context, userID, articleID int64) error {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec("DELETE FROM favorites WHERE user_id = ? AND article_id = ?", userID, articleID)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return favoriteEvent(tx, biz.EventArticleUnfavorited, userID, articleID)
+	})
+	if err != nil {
+		r.log.Errorf("RemoveFavorite error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *RealWorldRepo) DeleteArticle(ctx context.Context, id int64) error {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 评论、收藏、标签关联、历史版本和 slug 历史都随外键级联删除
+		var arts []*biz.Article
+		if err := tx.Raw("DELETE FROM articles WHERE id = ? RETURNING *", id).Scan(&arts).Error; err != nil {
+			return err
+		}
+		if len(arts) == 0 {
+			return nil
+		}
+		return articleEvent(tx, biz.EventArticleDeleted, arts[0])
+	})
+	if err != nil {
+		r.log.Errorf("DeleteArticle error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *RealWorldRepo) SetArticleStatus(ctx context.Context, id int64, status string) (*biz.Article, error) {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now(),
+		"version":    gorm.Expr("version + 1"),
+	}
+	if status == biz.ArticleStatusPublished || status == biz.ArticleStatusUnlisted {
+		// 保留首次发布时间；手动发布后定时任务就不需要了
+		updates["published_at"] = gorm.Expr("COALESCE(published_at, NOW())")
+		updates["scheduled_at"] = nil
+	}
+	var art biz.Article
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&biz.Article{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := tx.First(&art, id).Error; err != nil {
+			return err
+		}
+		return articleEvent(tx, biz.EventArticleStatusChanged, &art)
+	})
+	if err != nil {
+		r.log.Errorf("SetArticleStatus error: %v", err)
+		return nil, err
+	}
+	return &art, nil
+}
@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// commentViewColumns 评论列表的公共列，参数是当前用户 id
+const commentViewColumns = `c.id, c.body, c.author_id, c.article_id, c.parent_id, c.depth, c.deleted_at,
+	c.edited_at, c.created_at, c.updated_at,
+	u.username AS author_name, COALESCE(u.bio, '') AS author_bio, COALESCE(u.image, '') AS author_image,
+	EXISTS (SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = u.id) AS following,
+	(SELECT COUNT(*) FROM comments r WHERE r.parent_id = c.id) AS reply_count`
+
+func (r *RealWorldRepo) commentViews(ctx context.Context, myid int64) *gorm.DB {
+	return r.data.DB.WithContext(ctx).
+		Table("comments c").
+		Joins("JOIN users u ON u.id = c.author_id").
+		Select(commentViewColumns, myid)
+}
+
+func (r *RealWorldRepo) CreateComment(ctx context.Context, c *biz.Comment) (*biz.Comment, error) {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(c).Error; err != nil {
+			return err
+		}
+		return commentEvent(tx, biz.EventCommentCreated, c)
+	})
+	if err != nil {
+		r.log.Errorf("CreateComment error: %v", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *RealWorldRepo) GetComment(ctx context.Context, myid int64, id int64) (*biz.CommentView, error) {
+	var c biz.CommentView
+	res := r.commentViews(ctx, myid).Where("c.id = ?", id).Take(&c)
+	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if res.Error != nil {
+		r.log.Errorf("GetComment error: %v", res.Error)
+		return nil, res.Error
+	}
+	return &c, nil
+}
+
+func (r *RealWorldRepo) ListComments(ctx context.Context, myid, articleID, parentID int64, p *biz.Page) ([]*biz.CommentView, error) {
+	var list []*biz.CommentView
+	db := r.commentViews(ctx, myid).Where("c.article_id = ?", articleID)
+	if parentID == 0 {
+		db = db.Where("c.parent_id IS NULL")
+	} else {
+		db = db.Where("c.parent_id = ?", parentID)
+	}
+	if err := applyPage(db, p, "c.created_at", "c.id").Scan(&list).Error; err != nil {
+		r.log.Errorf("ListComments error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *RealWorldRepo) DeleteComment(ctx context.Context, id int64) error {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var c biz.Comment
+		if err := tx.First(&c, id).Error; err != nil {
+			return err
+		}
+		if err := deleteComment(tx, id); err != nil {
+			return err
+		}
+		return commentEvent(tx, biz.EventCommentDeleted, &c)
+	})
+	if err != nil {
+		r.log.Errorf("DeleteComment error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func deleteComment(tx *gorm.DB, id int64) error {
+	// 有回复时留下占位，回复仍挂在原来的位置
+	res := tx.Exec(`UPDATE comments SET body = '', deleted_at = NOW(), updated_at = NOW()
+		WHERE id = ? AND EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = comments.id)`, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected > 0 {
+		// 占位没有正文，其中的提及也一并清掉
+		return tx.Exec("DELETE FROM mentions WHERE comment_id = ?", id).Error
+	}
+	// 没有回复时直接删除，再沿着父评论往上清理不再有回复的占位
+	for id != 0 {
+		var deleted struct{ ParentID *int64 }
+		if err := tx.Raw("DELETE FROM comments WHERE id = ? RETURNING parent_id", id).
+			Scan(&deleted).Error; err != nil {
+			return err
+		}
+		parentID := deleted.ParentID
+		if parentID == nil {
+			return nil
+		}
+		var orphan bool
+		if err := tx.Raw(`SELECT deleted_at IS NOT NULL
+			AND NOT EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = c.id)
+			FROM comments c WHERE c.id = ?`, *parentID).
+			Scan(&orphan).Error; err != nil {
+			return err
+		}
+		id = 0
+		if orphan {
+			id = *parentID
+		}
+	}
+	return nil
+}
+
+func (r *RealWorldRepo) UpdateComment(ctx context.Context, id int64, body string, window time.Duration) error {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 修改期限在数据库里和写入 created_at 的 NOW() 比较，不受应用服务器时区影响
+		res := tx.Exec(`INSERT INTO comment_edits (comment_id, body, created_at)
+			SELECT id, body, NOW() FROM comments WHERE id = ? AND created_at > NOW() - make_interval(secs => ?)`,
+			id, window.Seconds())
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrCommentEditClosed
+		}
+		if err := tx.Exec("UPDATE comments SET body = ?, edited_at = NOW(), updated_at = NOW() WHERE id = ?", body, id).Error; err != nil {
+			return err
+		}
+		var c biz.Comment
+		if err := tx.First(&c, id).Error; err != nil {
+			return err
+		}
+		return commentEvent(tx, biz.EventCommentUpdated, &c)
+	})
+	if err != nil && !errors.Is(err, biz.ErrCommentEditClosed) {
+		r.log.Errorf("UpdateComment error: %v", err)
+	}
+	return err
+}
+
+func (r *RealWorldRepo) ListCommentEdits(ctx context.Context, id int64) ([]*biz.CommentEdit, error) {
+	var list []*biz.CommentEdit
+	if err := r.data.DB.WithContext(ctx).
+		Where("comment_id = ?", id).
+		Order("id DESC").
+		Find(&list).Error; err != nil {
+		r.log.Errorf("ListCommentEdits error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
@@ -18,7 +18,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewRealWorldRepo)
+var ProviderSet = wire.NewSet(NewData, NewRealWorldRepo, NewSuggestionRepo, NewSearchRepo, NewScheduleRepo, NewLocker, NewRevisionRepo, NewMarkdownRepo, NewViewRepo, NewRelatedRepo, NewReactionRepo, NewNotificationRepo, NewMentionRepo, NewLiveRepo, NewPresenceRepo, NewWebhookRepo, NewWebhookSender, NewOutboxRepo, NewEventPublisher)
 
 // Data .
 type Data struct {
@@ -0,0 +1,276 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	// 每个频道保留的最近事件数，断线太久超出这个范围的事件不再补发
+	liveHistoryLen = 1000
+	// 频道长时间没有新事件时整个历史过期
+	liveHistoryTTL = 24 * time.Hour
+	// 订阅端缓冲的事件数，客户端读得慢时在这里排队
+	liveBufferSize = 64
+	// 缓冲一直满着超过这个时间就结束订阅，由客户端重新订阅并从历史补发，而不是丢掉事件
+	liveSendTimeout = 10 * time.Second
+)
+
+type LiveRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLiveRepo .
+func NewLiveRepo(data *Data, logger log.Logger) biz.LiveRepo {
+	return &LiveRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// liveStreamKey 保存频道最近事件的 stream，entry id 即事件 id
+func liveStreamKey(channel string) string {
+	return "live:stream:" + channel
+}
+
+// livePubSubKey 跨实例分发事件的 pub/sub 频道
+func livePubSubKey(channel string) string {
+	return "live:" + channel
+}
+
+func (r *LiveRepo) Publish(ctx context.Context, channel string, e *biz.LiveEvent) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	key := liveStreamKey(channel)
+	id, err := r.data.RDB.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: liveHistoryLen,
+		Approx: true,
+		Values: map[string]interface{}{"event": b},
+	}).Result()
+	if err != nil {
+		return err
+	}
+	e.ID = id
+	if b, err = json.Marshal(e); err != nil {
+		return err
+	}
+	_, err = r.data.RDB.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Expire(ctx, key, liveHistoryTTL)
+		pipe.Publish(ctx, livePubSubKey(channel), b)
+		return nil
+	})
+	return err
+}
+
+func (r *LiveRepo) Subscribe(ctx context.Context, channels []string, lastID string) (<-chan *biz.LiveEvent, error) {
+	keys := make([]string, len(channels))
+	byKey := make(map[string]string, len(channels))
+	for i, c := range channels {
+		keys[i] = livePubSubKey(c)
+		byKey[keys[i]] = c
+	}
+	sub := r.data.RDB.Subscribe(ctx, keys...)
+	// 订阅生效后再读历史，两者之间发布的事件会同时出现在历史和订阅里，按 id 去重
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		r.log.Errorf("Subscribe error: %v", err)
+		return nil, err
+	}
+	// 每个频道已经发出的最后一个事件
+	sent := make(map[string]streamID, len(channels))
+	type replayed struct {
+		channel string
+		event   *biz.LiveEvent
+	}
+	var replay []replayed
+	if last, ok := parseStreamID(lastID); ok {
+		for _, c := range channels {
+			sent[c] = last
+			list, err := r.history(ctx, c, lastID)
+			if err != nil {
+				sub.Close()
+				return nil, err
+			}
+			for _, e := range list {
+				replay = append(replay, replayed{channel: c, event: e})
+			}
+		}
+		// 各频道的 id 都按时间生成，合并后大致保持发生的顺序
+		sort.SliceStable(replay, func(i, j int) bool {
+			a, _ := parseStreamID(replay[i].event.ID)
+			b, _ := parseStreamID(replay[j].event.ID)
+			return a.less(b)
+		})
+	}
+
+	out := make(chan *biz.LiveEvent, liveBufferSize)
+	go func() {
+		defer close(out)
+		defer sub.Close()
+		send := func(c string, e *biz.LiveEvent) bool {
+			id, ok := parseStreamID(e.ID)
+			if !ok || !sent[c].less(id) {
+				return true
+			}
+			sent[c] = id
+			select {
+			case out <- e:
+				return true
+			default:
+			}
+			timer := time.NewTimer(liveSendTimeout)
+			defer timer.Stop()
+			select {
+			case out <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			case <-timer.C:
+				r.log.Warnf("live subscriber on %v is too slow, closing", channels)
+				return false
+			}
+		}
+		for _, x := range replay {
+			if !send(x.channel, x.event) {
+				return
+			}
+		}
+		msgs := sub.Channel(redis.WithChannelSize(liveBufferSize))
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				var e biz.LiveEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil {
+					r.log.Warnf("decode live event error: %v", err)
+					continue
+				}
+				if !send(byKey[msg.Channel], &e) {
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// history 读取频道中 id 大于 after 的事件
+func (r *LiveRepo) history(ctx context.Context, channel, after string) ([]*biz.LiveEvent, error) {
+	msgs, err := r.data.RDB.XRangeN(ctx, liveStreamKey(channel), "("+after, "+", liveHistoryLen).Result()
+	if err != nil {
+		r.log.Errorf("read live history error: %v", err)
+		return nil, err
+	}
+	list := make([]*biz.LiveEvent, 0, len(msgs))
+	for _, m := range msgs {
+		s, _ := m.Values["event"].(string)
+		var e biz.LiveEvent
+		if err := json.Unmarshal([]byte(s), &e); err != nil {
+			r.log.Warnf("decode live event error: %v", err)
+			continue
+		}
+		e.ID = m.ID
+		list = append(list, &e)
+	}
+	return list, nil
+}
+
+func (r *LiveRepo) FollowerIDs(ctx context.Context, userID int64) ([]int64, error) {
+	var ids []int64
+	if err := r.data.DB.WithContext(ctx).Raw(
+		"SELECT follower_id FROM follows WHERE followee_id = ?", userID).
+		Scan(&ids).Error; err != nil {
+		r.log.Errorf("FollowerIDs error: %v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *LiveRepo) Blocked(ctx context.Context, a, b int64) (bool, error) {
+	blocked, err := blockedEither(ctx, r.data.DB, a, b)
+	if err != nil {
+		r.log.Errorf("Blocked error: %v", err)
+		return false, err
+	}
+	return blocked, nil
+}
+
+// liveTicketKey 推送票据，值为所属用户 id
+func liveTicketKey(ticket string) string {
+	return "live:ticket:" + ticket
+}
+
+func (r *LiveRepo) SaveTicket(ctx context.Context, ticket string, userID int64, ttl time.Duration) error {
+	if err := r.data.RDB.Set(ctx, liveTicketKey(ticket), userID, ttl).Err(); err != nil {
+		r.log.Errorf("SaveTicket error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *LiveRepo) TakeTicket(ctx context.Context, ticket string) (int64, error) {
+	// GETDEL 保证票据只能用一次
+	userID, err := r.data.RDB.GetDel(ctx, liveTicketKey(ticket)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		r.log.Errorf("TakeTicket error: %v", err)
+		return 0, err
+	}
+	return userID, nil
+}
+
+// blockedEither 两人之间任意一方拉黑了另一方
+func blockedEither(ctx context.Context, db *gorm.DB, a, b int64) (bool, error) {
+	var blocked bool
+	err := db.WithContext(ctx).Raw(`SELECT EXISTS (
+		SELECT 1 FROM blocks
+		WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?))`, a, b, b, a).
+		Scan(&blocked).Error
+	return blocked, err
+}
+
+// streamID Redis stream 的 entry id：毫秒时间戳-序号
+type streamID struct {
+	ms, seq uint64
+}
+
+func parseStreamID(s string) (streamID, bool) {
+	ms, seq, ok := strings.Cut(s, "-")
+	if !ok {
+		return streamID{}, false
+	}
+	var id streamID
+	var err error
+	if id.ms, err = strconv.ParseUint(ms, 10, 64); err != nil {
+		return streamID{}, false
+	}
+	if id.seq, err = strconv.ParseUint(seq, 10, 64); err != nil {
+		return streamID{}, false
+	}
+	return id, true
+}
+
+func (a streamID) less(b streamID) bool {
+	return a.ms < b.ms || a.ms == b.ms && a.seq < b.seq
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 只有持有者才能释放锁，防止锁过期后误删其他实例的锁
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+type Locker struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLocker .
+func NewLocker(data *Data, logger log.Logger) biz.Locker {
+	return &Locker{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (l *Locker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, err
+	}
+	token := hex.EncodeToString(b)
+	key = "lock:" + key
+	ok, err := l.data.RDB.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	unlock := func() {
+		// 业务 ctx 可能已经取消，释放锁单独给个超时
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := unlockScript.Run(ctx, l.data.RDB, []string{key}, token).Err(); err != nil {
+			l.log.Warnf("unlock %s error: %v", key, err)
+		}
+	}
+	return unlock, true, nil
+}
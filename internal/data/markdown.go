@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 渲染结果按内容版本缓存，内容变了 key 也会变，旧 key 靠过期清理
+const renderedHTMLTTL = 24 * time.Hour
+
+type MarkdownRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMarkdownRepo .
+func NewMarkdownRepo(data *Data, logger log.Logger) biz.MarkdownRepo {
+	return &MarkdownRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func htmlKey(k biz.HTMLKey) string {
+	return fmt.Sprintf("html:%s:%d:%d", k.Kind, k.ID, k.Revision)
+}
+
+func (r *MarkdownRepo) GetHTML(ctx context.Context, keys []biz.HTMLKey) ([]string, error) {
+	out := make([]string, len(keys))
+	if len(keys) == 0 {
+		return out, nil
+	}
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = htmlKey(k)
+	}
+	vals, err := r.data.RDB.MGet(ctx, names...).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	for i, v := range vals {
+		if s, ok := v.(string); ok {
+			out[i] = s
+		}
+	}
+	return out, nil
+}
+
+func (r *MarkdownRepo) SetHTML(ctx context.Context, keys []biz.HTMLKey, html []string) error {
+	_, err := r.data.RDB.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, k := range keys {
+			p.Set(ctx, htmlKey(k), html[i], renderedHTMLTTL)
+		}
+		return nil
+	})
+	return err
+}
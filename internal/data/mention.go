@@ -0,0 +1,150 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MentionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMentionRepo .
+func NewMentionRepo(data *Data, logger log.Logger) biz.MentionRepo {
+	return &MentionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// mentionRow mentions 表的一行
+type mentionRow struct {
+	ID          int64 `gorm:"primaryKey;autoIncrement"`
+	ArticleID   int64
+	CommentID   *int64
+	UserID      int64
+	StartOffset int
+	EndOffset   int
+	Notified    bool
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (mentionRow) TableName() string {
+	return "mentions"
+}
+
+// mentionSource 一段正文的提及对应的过滤条件，commentID 为 0 表示文章正文
+func mentionSource(db *gorm.DB, articleID, commentID int64) *gorm.DB {
+	if commentID == 0 {
+		return db.Where("article_id = ? AND comment_id IS NULL", articleID)
+	}
+	return db.Where("comment_id = ?", commentID)
+}
+
+func (r *MentionRepo) ReplaceMentions(ctx context.Context, articleID, commentID int64, list []*biz.Mention) error {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var before []struct {
+			UserID   int64
+			Notified bool
+		}
+		if err := mentionSource(tx.Table("mentions"), articleID, commentID).
+			Select("user_id, bool_or(notified) AS notified").Group("user_id").
+			Scan(&before).Error; err != nil {
+			return err
+		}
+		if err := mentionSource(tx, articleID, commentID).Delete(&mentionRow{}).Error; err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		var cid *int64
+		if commentID != 0 {
+			cid = &commentID
+		}
+		notified := make(map[int64]bool, len(before))
+		for _, b := range before {
+			notified[b.UserID] = b.Notified
+		}
+		rows := make([]*mentionRow, 0, len(list))
+		for _, m := range list {
+			rows = append(rows, &mentionRow{
+				ArticleID:   articleID,
+				CommentID:   cid,
+				UserID:      m.UserID,
+				StartOffset: m.Start,
+				EndOffset:   m.End,
+				Notified:    notified[m.UserID],
+			})
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		r.log.Errorf("ReplaceMentions error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *MentionRepo) MarkNotified(ctx context.Context, articleID, commentID int64) ([]int64, error) {
+	var rows []mentionRow
+	// 同时进行的两次调用由行锁排队，后一个不会再改到已经标记的行，每个用户只会被通知一次
+	if err := mentionSource(r.data.DB.WithContext(ctx).Model(&rows), articleID, commentID).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "user_id"}}}).
+		Where("NOT notified").
+		Update("notified", true).Error; err != nil {
+		r.log.Errorf("MarkNotified error: %v", err)
+		return nil, err
+	}
+	seen := make(map[int64]bool, len(rows))
+	var ids []int64
+	for _, m := range rows {
+		if !seen[m.UserID] {
+			seen[m.UserID] = true
+			ids = append(ids, m.UserID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *MentionRepo) ListMentions(ctx context.Context, source string, ids []int64) (map[int64][]*biz.Mention, error) {
+	column := "m.article_id"
+	db := r.data.DB.WithContext(ctx).Table("mentions m").Joins("JOIN users u ON u.id = m.user_id")
+	if source == biz.MentionSourceComment {
+		column = "m.comment_id"
+	} else {
+		db = db.Where("m.comment_id IS NULL")
+	}
+	var rows []struct {
+		SourceID int64
+		biz.Mention
+	}
+	if err := db.Select(column+" AS source_id, m.user_id, u.username, m.start_offset, m.end_offset").
+		Where(column+" IN ?", ids).
+		Order("m.start_offset").
+		Scan(&rows).Error; err != nil {
+		r.log.Errorf("ListMentions error: %v", err)
+		return nil, err
+	}
+	res := make(map[int64][]*biz.Mention, len(ids))
+	for i := range rows {
+		res[rows[i].SourceID] = append(res[rows[i].SourceID], &rows[i].Mention)
+	}
+	return res, nil
+}
+
+func (r *MentionRepo) Blocked(ctx context.Context, a, b int64) (bool, error) {
+	blocked, err := blockedEither(ctx, r.data.DB, a, b)
+	if err != nil {
+		r.log.Errorf("Blocked error: %v", err)
+		return false, err
+	}
+	return blocked, nil
+}
@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 每条通知带回的最近触发人数
+const notificationRecentActors = 3
+
+type NotificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewNotificationRepo .
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &NotificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *NotificationRepo) AddNotification(ctx context.Context, e *biz.NotificationEvent) (int64, error) {
+	var articleID *int64
+	if e.ArticleID != 0 {
+		articleID = &e.ArticleID
+	}
+	var id int64
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 接收人拉黑了触发人时不通知
+		var blocked bool
+		if err := tx.Raw("SELECT EXISTS (SELECT 1 FROM blocks WHERE blocker_id = ? AND blocked_id = ?)",
+			e.RecipientID, e.ActorID).Scan(&blocked).Error; err != nil {
+			return err
+		}
+		if blocked {
+			return nil
+		}
+		// 有同类型同文章的未读通知时合并进去，否则新建一条
+		if err := tx.Raw(`
+			INSERT INTO notifications (user_id, kind, article_id, created_at, updated_at)
+			VALUES (?, ?, ?, NOW(), NOW())
+			ON CONFLICT (user_id, kind, COALESCE(article_id, 0)) WHERE read_at IS NULL
+			DO UPDATE SET updated_at = NOW()
+			RETURNING id`, e.RecipientID, e.Kind, articleID).
+			Scan(&id).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`
+			INSERT INTO notification_actors (notification_id, actor_id, created_at) VALUES (?, ?, NOW())
+			ON CONFLICT (notification_id, actor_id) DO UPDATE SET created_at = NOW()`, id, e.ActorID).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			UPDATE notifications
+			SET actors_count = (SELECT COUNT(*) FROM notification_actors WHERE notification_id = ?)
+			WHERE id = ?`, id, id).Error
+	})
+	if err != nil {
+		r.log.Errorf("AddNotification error: %v", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *NotificationRepo) ListNotifications(ctx context.Context, userID int64, unreadOnly bool, p *biz.Page) ([]*biz.Notification, error) {
+	db := r.data.DB.WithContext(ctx).
+		Table("notifications n").
+		// 文章改回草稿后只有作者看得到，其他收件人的通知里不再带出标题和 slug
+		Joins("LEFT JOIN articles a ON a.id = n.article_id AND (a.status <> ? OR a.author_id = n.user_id)",
+			biz.ArticleStatusDraft).
+		Select(`n.id, n.kind, a.slug AS article_slug, a.title AS article_title, n.actors_count,
+			n.read_at, n.created_at, n.updated_at`).
+		Where("n.user_id = ?", userID)
+	if unreadOnly {
+		db = db.Where("n.read_at IS NULL")
+	}
+	var list []*biz.Notification
+	if err := applyPage(db, p, "n.updated_at", "n.id").Scan(&list).Error; err != nil {
+		r.log.Errorf("ListNotifications error: %v", err)
+		return nil, err
+	}
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	ids := make([]int64, len(list))
+	byID := make(map[int64]*biz.Notification, len(list))
+	for i, n := range list {
+		ids[i] = n.ID
+		byID[n.ID] = n
+	}
+	// 每条通知最近的几位触发人，最新的在前
+	var actors []*biz.NotificationActor
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT notification_id, username, image FROM (
+			SELECT na.notification_id, u.username, u.image, na.created_at,
+				ROW_NUMBER() OVER (PARTITION BY na.notification_id ORDER BY na.created_at DESC, na.actor_id DESC) AS rn
+			FROM notification_actors na
+			JOIN users u ON u.id = na.actor_id
+			WHERE na.notification_id IN ?
+		) recent
+		WHERE rn <= ?
+		ORDER BY notification_id, rn`, ids, notificationRecentActors).
+		Scan(&actors).Error; err != nil {
+		r.log.Errorf("list notification actors error: %v", err)
+		return nil, err
+	}
+	for _, a := range actors {
+		n := byID[a.NotificationID]
+		n.Actors = append(n.Actors, a)
+	}
+	return list, nil
+}
+
+func (r *NotificationRepo) MarkRead(ctx context.Context, userID, id int64) (bool, error) {
+	var found bool
+	if err := r.data.DB.WithContext(ctx).Raw(
+		"SELECT EXISTS (SELECT 1 FROM notifications WHERE id = ? AND user_id = ?)", id, userID).
+		Scan(&found).Error; err != nil {
+		r.log.Errorf("MarkRead error: %v", err)
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+	// 已读的通知保持原来的已读时间
+	if err := r.data.DB.WithContext(ctx).Exec(
+		"UPDATE notifications SET read_at = NOW() WHERE id = ? AND read_at IS NULL", id).Error; err != nil {
+		r.log.Errorf("MarkRead error: %v", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *NotificationRepo) MarkAllRead(ctx context.Context, userID int64) error {
+	if err := r.data.DB.WithContext(ctx).Exec(
+		"UPDATE notifications SET read_at = NOW() WHERE user_id = ? AND read_at IS NULL", userID).Error; err != nil {
+		r.log.Errorf("MarkAllRead error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *NotificationRepo) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	var n int64
+	if err := r.data.DB.WithContext(ctx).Raw(
+		"SELECT COUNT(*) FROM notifications WHERE user_id = ? AND read_at IS NULL", userID).
+		Scan(&n).Error; err != nil {
+		r.log.Errorf("UnreadCount error: %v", err)
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// 每个 Redis Stream 默认大约保留的事件数
+const defaultEventStreamMaxLen = 100000
+
+// writeOutbox 在 tx 中写入一条领域事件，随 tx 一起提交或回滚。
+// id 在插入时分配而不是提交时：先拿到聚合的事务级咨询锁再插入，同一聚合后写事件的事务要等前一个提交，
+// 这样同一聚合的事件 id 顺序就是提交顺序，中继按 id 发布不会让后面的事件越过还没提交的前一个
+func writeOutbox(tx *gorm.DB, aggregateType string, aggregateID int64, typ string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?), ?)", aggregateType, int32(aggregateID)).Error; err != nil {
+		return err
+	}
+	return tx.Exec(`INSERT INTO outbox (aggregate_type, aggregate_id, event_type, payload, created_at)
+		VALUES (?, ?, ?, ?, NOW())`, aggregateType, aggregateID, typ, string(b)).Error
+}
+
+func articleEvent(tx *gorm.DB, typ string, art *biz.Article) error {
+	return writeOutbox(tx, biz.AggregateArticle, art.ID, typ, &biz.ArticleEventData{
+		ID:          art.ID,
+		AuthorID:    art.AuthorID,
+		Slug:        art.Slug,
+		Title:       art.Title,
+		Status:      art.Status,
+		Version:     art.Version,
+		PublishedAt: art.PublishedAt,
+	})
+}
+
+func commentEvent(tx *gorm.DB, typ string, c *biz.Comment) error {
+	data := &biz.CommentEventData{ID: c.ID, ArticleID: c.ArticleID, AuthorID: c.AuthorID}
+	if c.ParentID != nil {
+		data.ParentID = *c.ParentID
+	}
+	return writeOutbox(tx, biz.AggregateArticle, c.ArticleID, typ, data)
+}
+
+func favoriteEvent(tx *gorm.DB, typ string, userID, articleID int64) error {
+	return writeOutbox(tx, biz.AggregateArticle, articleID, typ, &biz.FavoriteEventData{UserID: userID, ArticleID: articleID})
+}
+
+func followEvent(tx *gorm.DB, typ string, followerID, followeeID int64) error {
+	return writeOutbox(tx, biz.AggregateUser, followeeID, typ, &biz.FollowEventData{FollowerID: followerID, FolloweeID: followeeID})
+}
+
+type OutboxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOutboxRepo .
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	return &OutboxRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *OutboxRepo) ListPending(ctx context.Context, limit int) ([]*biz.DomainEvent, error) {
+	var list []*biz.DomainEvent
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at
+		FROM outbox WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT ?`, limit).
+		Scan(&list).Error; err != nil {
+		r.log.Errorf("ListPending error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *OutboxRepo) MarkPublished(ctx context.Context, ids []int64) error {
+	if err := r.data.DB.WithContext(ctx).Exec(
+		"UPDATE outbox SET published_at = NOW() WHERE id IN ?", ids).Error; err != nil {
+		r.log.Errorf("MarkPublished error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *OutboxRepo) DeletePublished(ctx context.Context, before time.Time) error {
+	if err := r.data.DB.WithContext(ctx).Exec(
+		"DELETE FROM outbox WHERE published_at < ?", before).Error; err != nil {
+		r.log.Errorf("DeletePublished error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// NewEventPublisher returns the publisher selected by the outbox config, Redis Streams by default.
+func NewEventPublisher(c *conf.Biz, data *Data, logger log.Logger) biz.EventPublisher {
+	if c.GetOutbox().GetPublisher() == "memory" {
+		return NewMemoryPublisher()
+	}
+	maxLen := c.GetOutbox().GetStreamMaxLen()
+	if maxLen <= 0 {
+		maxLen = defaultEventStreamMaxLen
+	}
+	return &RedisPublisher{data: data, maxLen: maxLen, log: log.NewHelper(logger)}
+}
+
+// RedisPublisher appends events to one Redis Stream per aggregate type, events:{aggregate_type}.
+type RedisPublisher struct {
+	data   *Data
+	maxLen int64
+	log    *log.Helper
+}
+
+// eventStreamKey 每种聚合一个 stream，消费方用消费组读取
+func eventStreamKey(aggregateType string) string {
+	return "events:" + aggregateType
+}
+
+func (p *RedisPublisher) Publish(ctx context.Context, e *biz.DomainEvent) error {
+	if err := p.data.RDB.XAdd(ctx, &redis.XAddArgs{
+		Stream: eventStreamKey(e.AggregateType),
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":           strconv.FormatInt(e.ID, 10),
+			"type":         e.Type,
+			"aggregate_id": strconv.FormatInt(e.AggregateID, 10),
+			"payload":      e.Payload,
+			"created_at":   e.CreatedAt.Format(time.RFC3339Nano),
+		},
+	}).Err(); err != nil {
+		p.log.Errorf("publish event %d error: %v", e.ID, err)
+		return err
+	}
+	return nil
+}
+
+// MemoryPublisher keeps published events in memory, for tests.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []*biz.DomainEvent
+}
+
+// NewMemoryPublisher .
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, e *biz.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, e)
+	return nil
+}
+
+// Events returns the events published so far, in order.
+func (p *MemoryPublisher) Events() []*biz.DomainEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*biz.DomainEvent(nil), p.events...)
+}
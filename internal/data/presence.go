@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+type PresenceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPresenceRepo .
+func NewPresenceRepo(data *Data, logger log.Logger) biz.PresenceRepo {
+	return &PresenceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+const (
+	presenceKeyPrefix = "user:online:"
+	// presenceIndexKey 在线用户的有序集合，分数是最后一次刷新的毫秒时间戳，用来找出已经过期的用户
+	presenceIndexKey = "presence:online"
+)
+
+// presenceKey 用户在线时存在，值为最后一次刷新的毫秒时间戳，过期即离线
+func presenceKey(userID int64) string {
+	return fmt.Sprintf("%s%d", presenceKeyPrefix, userID)
+}
+
+// expireOnline 取出索引里最后刷新不晚于 ARGV[1] 毫秒、在线标记也已经过期的用户，从索引中删掉并返回用户 id 和分数。
+// 检查和删除在同一个脚本里，多个实例同时清理时每个用户只会被一个实例取到；期间重新上线的用户标记还在，不会被取走。
+// KEYS[1] 是索引，ARGV[2] 是最多取的个数，ARGV[3] 是在线标记的前缀
+var expireOnline = redis.NewScript(`
+local list = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "WITHSCORES", "LIMIT", 0, ARGV[2])
+local out = {}
+for i = 1, #list, 2 do
+	if redis.call("EXISTS", ARGV[3] .. list[i]) == 0 then
+		redis.call("ZREM", KEYS[1], list[i])
+		table.insert(out, list[i])
+		table.insert(out, list[i + 1])
+	end
+end
+return out`)
+
+func (r *PresenceRepo) Touch(ctx context.Context, userID int64, now time.Time, ttl, refresh time.Duration) (bool, error) {
+	key := presenceKey(userID)
+	last, err := r.data.RDB.Get(ctx, key).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.log.Errorf("Touch error: %v", err)
+		return false, err
+	}
+	// 以前登录时写入的值是 "true"，不是时间戳的按需要刷新处理
+	if ms, perr := strconv.ParseInt(last, 10, 64); err == nil && perr == nil && now.Sub(time.UnixMilli(ms)) < refresh {
+		return false, nil
+	}
+	// 两个请求同时刷新时以 SET 返回的旧值为准，只有一个会得到“之前不在线”
+	_, err = r.data.RDB.SetArgs(ctx, key, strconv.FormatInt(now.UnixMilli(), 10), redis.SetArgs{TTL: ttl, Get: true}).Result()
+	cameOnline := errors.Is(err, redis.Nil)
+	if err != nil && !cameOnline {
+		r.log.Errorf("Touch error: %v", err)
+		return false, err
+	}
+	if err := r.data.RDB.ZAdd(ctx, presenceIndexKey, redis.Z{Score: float64(now.UnixMilli()), Member: userID}).Err(); err != nil {
+		r.log.Errorf("Touch error: %v", err)
+		return false, err
+	}
+	if err := r.data.DB.WithContext(ctx).Exec(
+		"UPDATE users SET last_seen_at = ? WHERE id = ?", now, userID).Error; err != nil {
+		r.log.Errorf("Touch error: %v", err)
+		return false, err
+	}
+	return cameOnline, nil
+}
+
+func (r *PresenceRepo) ExpireOnline(ctx context.Context, before time.Time, limit int) (map[int64]time.Time, error) {
+	res, err := expireOnline.Run(ctx, r.data.RDB, []string{presenceIndexKey},
+		before.UnixMilli(), limit, presenceKeyPrefix).StringSlice()
+	if err != nil {
+		r.log.Errorf("ExpireOnline error: %v", err)
+		return nil, err
+	}
+	expired := make(map[int64]time.Time, len(res)/2)
+	for i := 0; i+1 < len(res); i += 2 {
+		id, err := strconv.ParseInt(res[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		ms, _ := strconv.ParseFloat(res[i+1], 64)
+		expired[id] = time.UnixMilli(int64(ms))
+	}
+	return expired, nil
+}
+
+func (r *PresenceRepo) ListPresence(ctx context.Context, usernames []string) ([]*biz.Presence, error) {
+	var list []*biz.Presence
+	if err := r.data.DB.WithContext(ctx).Raw(
+		"SELECT id, username, last_seen_at, hide_presence FROM users WHERE username IN ?", usernames).
+		Scan(&list).Error; err != nil {
+		r.log.Errorf("ListPresence error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *PresenceRepo) Online(ctx context.Context, ids []int64) (map[int64]bool, error) {
+	online := make(map[int64]bool, len(ids))
+	if len(ids) == 0 {
+		return online, nil
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = presenceKey(id)
+	}
+	n, err := r.data.RDB.MGet(ctx, keys...).Result()
+	if err != nil {
+		r.log.Errorf("Online error: %v", err)
+		return nil, err
+	}
+	for i, v := range n {
+		online[ids[i]] = v != nil
+	}
+	return online, nil
+}
+
+func (r *PresenceRepo) SetHidden(ctx context.Context, userID int64, hidden bool) error {
+	if err := r.data.DB.WithContext(ctx).Exec(
+		"UPDATE users SET hide_presence = ? WHERE id = ?", hidden, userID).Error; err != nil {
+		r.log.Errorf("SetHidden error: %v", err)
+		return err
+	}
+	return nil
+}
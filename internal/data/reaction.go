@@ -0,0 +1,231 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// 计数哈希的过期时间，过期后从数据库重建
+	reactionCountsTTL = 24 * time.Hour
+	// 重建时写入的标记字段，没有任何表态的对象也能命中缓存
+	reactionLoadedField = "_"
+)
+
+// cacheCounts 写入从数据库重建的计数。KEYS[1] 是计数哈希，KEYS[2] 是写入代数；
+// 只有哈希仍不存在、且读库之前取到的代数 ARGV[1] 没有变过时才写，期间有表态变化就放弃，留给下次读取重建。
+// ARGV[2] 是过期秒数，之后是字段和值
+var cacheCounts = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+if (redis.call("GET", KEYS[2]) or "0") ~= ARGV[1] then
+	return 0
+end
+redis.call("HSET", KEYS[1], unpack(ARGV, 3))
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+return 1`)
+
+type ReactionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReactionRepo .
+func NewReactionRepo(data *Data, logger log.Logger) biz.ReactionRepo {
+	return &ReactionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// reactionTable 表态对象对应的表和对象 id 列
+func reactionTable(target string) (table, column string) {
+	if target == biz.ReactionTargetComment {
+		return "comment_reactions", "comment_id"
+	}
+	return "article_reactions", "article_id"
+}
+
+func reactionsKey(target string, id int64) string {
+	return fmt.Sprintf("reactions:%s:%d", target, id)
+}
+
+// reactionsGenKey 每次表态变化加一，重建计数时用来判断读库期间有没有写入
+func reactionsGenKey(target string, id int64) string {
+	return reactionsKey(target, id) + ":gen"
+}
+
+func (r *ReactionRepo) AddReaction(ctx context.Context, target string, targetID, userID int64, reaction string) (bool, error) {
+	table, column := reactionTable(target)
+	res := r.data.DB.WithContext(ctx).Exec(
+		"INSERT INTO "+table+" ("+column+", user_id, reaction, created_at) VALUES (?, ?, ?, NOW()) ON CONFLICT DO NOTHING",
+		targetID, userID, reaction)
+	if res.Error != nil {
+		r.log.Errorf("AddReaction error: %v", res.Error)
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidate(ctx, target, targetID)
+	return true, nil
+}
+
+func (r *ReactionRepo) RemoveReaction(ctx context.Context, target string, targetID, userID int64, reaction string) (bool, error) {
+	table, column := reactionTable(target)
+	res := r.data.DB.WithContext(ctx).Exec(
+		"DELETE FROM "+table+" WHERE "+column+" = ? AND user_id = ? AND reaction = ?",
+		targetID, userID, reaction)
+	if res.Error != nil {
+		r.log.Errorf("RemoveReaction error: %v", res.Error)
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidate(ctx, target, targetID)
+	return true, nil
+}
+
+// invalidate 表态落库后删掉缓存的计数，并推进代数让正在重建的读取放弃写入
+func (r *ReactionRepo) invalidate(ctx context.Context, target string, id int64) {
+	gen := reactionsGenKey(target, id)
+	_, err := r.data.RDB.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Incr(ctx, gen)
+		pipe.Expire(ctx, gen, reactionCountsTTL)
+		pipe.Del(ctx, reactionsKey(target, id))
+		return nil
+	})
+	if err != nil {
+		r.log.Warnf("invalidate reaction counts error: %v", err)
+	}
+}
+
+func (r *ReactionRepo) ReactionCounts(ctx context.Context, target string, ids []int64) (map[int64]map[string]int64, error) {
+	res := make(map[int64]map[string]int64, len(ids))
+	cmds := make([]*redis.MapStringStringCmd, len(ids))
+	gens := make([]*redis.StringCmd, len(ids))
+	// 没有写过的对象没有代数，GET 返回 redis.Nil，这里逐条检查错误
+	_, _ = r.data.RDB.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, id := range ids {
+			cmds[i] = pipe.HGetAll(ctx, reactionsKey(target, id))
+			// 代数要在读库之前取
+			gens[i] = pipe.Get(ctx, reactionsGenKey(target, id))
+		}
+		return nil
+	})
+
+	var (
+		missing    []int64
+		missingGen = make(map[int64]string)
+		cacheErr   error
+	)
+	for i, id := range ids {
+		fields, err := cmds[i].Result()
+		if err != nil && cacheErr == nil {
+			cacheErr = err
+		}
+		if err != nil || len(fields) == 0 {
+			missing = append(missing, id)
+			missingGen[id] = "0"
+			if g := gens[i].Val(); g != "" {
+				missingGen[id] = g
+			}
+			continue
+		}
+		counts := make(map[string]int64, len(fields))
+		for k, v := range fields {
+			if k == reactionLoadedField {
+				continue
+			}
+			n, _ := strconv.ParseInt(v, 10, 64)
+			counts[k] = n
+		}
+		res[id] = counts
+	}
+	if cacheErr != nil {
+		// 缓存不可用时从数据库读
+		r.log.Warnf("get reaction counts error: %v", cacheErr)
+	}
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	loaded, err := r.loadCounts(ctx, target, missing)
+	if err != nil {
+		return nil, err
+	}
+	// 管道里没法在 NOSCRIPT 时回退，直接用 EVAL
+	_, err = r.data.RDB.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range missing {
+			args := []interface{}{missingGen[id], int(reactionCountsTTL / time.Second), reactionLoadedField, 1}
+			for k, n := range loaded[id] {
+				args = append(args, k, n)
+			}
+			cacheCounts.Eval(ctx, pipe, []string{reactionsKey(target, id), reactionsGenKey(target, id)}, args...)
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Warnf("cache reaction counts error: %v", err)
+	}
+	for _, id := range missing {
+		res[id] = loaded[id]
+	}
+	return res, nil
+}
+
+func (r *ReactionRepo) loadCounts(ctx context.Context, target string, ids []int64) (map[int64]map[string]int64, error) {
+	table, column := reactionTable(target)
+	var rows []struct {
+		TargetID int64
+		Reaction string
+		Count    int64
+	}
+	if err := r.data.DB.WithContext(ctx).Raw(
+		"SELECT "+column+" AS target_id, reaction, COUNT(*) AS count FROM "+table+
+			" WHERE "+column+" IN ? GROUP BY "+column+", reaction", ids).
+		Scan(&rows).Error; err != nil {
+		r.log.Errorf("load reaction counts error: %v", err)
+		return nil, err
+	}
+	res := make(map[int64]map[string]int64, len(ids))
+	for _, row := range rows {
+		if res[row.TargetID] == nil {
+			res[row.TargetID] = make(map[string]int64)
+		}
+		res[row.TargetID][row.Reaction] = row.Count
+	}
+	return res, nil
+}
+
+func (r *ReactionRepo) UserReactions(ctx context.Context, target string, userID int64, ids []int64) (map[int64][]string, error) {
+	res := make(map[int64][]string, len(ids))
+	if userID == 0 || len(ids) == 0 {
+		return res, nil
+	}
+	table, column := reactionTable(target)
+	var rows []struct {
+		TargetID int64
+		Reaction string
+	}
+	if err := r.data.DB.WithContext(ctx).Raw(
+		"SELECT "+column+" AS target_id, reaction FROM "+table+
+			" WHERE user_id = ? AND "+column+" IN ?", userID, ids).
+		Scan(&rows).Error; err != nil {
+		r.log.Errorf("UserReactions error: %v", err)
+		return nil, err
+	}
+	for _, row := range rows {
+		res[row.TargetID] = append(res[row.TargetID], row.Reaction)
+	}
+	return res, nil
+}
@@ -55,16 +55,29 @@ func (r *RealWorldRepo) FindByID(ctx context.Context, id int64) (*biz.RealWorld,
 	return &user, nil
 }
 
-func (r *RealWorldRepo) UpdateUser(ctx context.Context, user *biz.RealWorld) (*biz.RealWorld, error) {
+func (r *RealWorldRepo) UpdateUser(ctx context.Context, user *biz.RealWorld, fields []string) (*biz.RealWorld, error) {
 	updateData := map[string]interface{}{}
-	if user.UserName != "" {
-		updateData["username"] = user.UserName
-	}
-	if user.Bio != "" {
-		updateData["bio"] = user.Bio
+	if fields == nil {
+		if user.UserName != "" {
+			updateData["username"] = user.UserName
+		}
+		if user.Bio != "" {
+			updateData["bio"] = user.Bio
+		}
+		if user.Image != "" {
+			updateData["image"] = user.Image
+		}
 	}
-	if user.Image != "" {
-		updateData["image"] = user.Image
+	// 带了 update_mask 时按 mask 更新，空值也写入
+	for _, f := range fields {
+		switch f {
+		case "username":
+			updateData["username"] = user.UserName
+		case "bio":
+			updateData["bio"] = user.Bio
+		case "image":
+			updateData["image"] = user.Image
+		}
 	}
 
 	// 没有要更新的字段直接返回
@@ -72,19 +85,31 @@ func (r *RealWorldRepo) UpdateUser(ctx context.Context, user *biz.RealWorld) (*b
 		return nil, fmt.Errorf("no data need be changed")
 	}
 
-	// GORM 更新（自动 WHERE id = ?）
-	res := r.data.DB.WithContext(ctx).
+	updateData["version"] = gorm.Expr("version + 1")
+
+	// GORM 更新（自动 WHERE id = ?）；带了期望版本时做条件更新
+	db := r.data.DB.WithContext(ctx).
 		Model(&biz.RealWorld{}).
-		Where("id = ?", user.ID).
-		Updates(updateData)
+		Where("id = ?", user.ID)
+	if user.Version > 0 {
+		db = db.Where("version = ?", user.Version)
+	}
+	res := db.Updates(updateData)
 
 	if res.Error != nil {
 		return nil, res.Error
 	}
 	if res.RowsAffected == 0 {
+		if user.Version > 0 {
+			return nil, biz.ErrVersionConflict
+		}
 		return nil, errors.New("no user updated")
 	}
-	return user, nil
+	var updated biz.RealWorld
+	if err := r.data.DB.WithContext(ctx).First(&updated, user.ID).Error; err != nil {
+		return nil, err
+	}
+	return &updated, nil
 }
 
 func (r *RealWorldRepo) FindByUserName(ctx context.Context, username string) (*biz.RealWorld, error) {
@@ -152,9 +177,13 @@ func (r *RealWorldRepo) AFollowB(ctx context.Context, myid int64, otherid int64)
 		"created_at":  time.Now(),
 	}
 
-	if err := r.data.DB.WithContext(ctx).
-		Table("follows").
-		Create(&follow).Error; err != nil {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("follows").Create(&follow).Error; err != nil {
+			return err
+		}
+		return followEvent(tx, biz.EventUserFollowed, myid, otherid)
+	})
+	if err != nil {
 		r.log.Errorf("AFollowB insert error: %v", err)
 		return err
 	}
@@ -186,10 +215,16 @@ func (r *RealWorldRepo) AUnFollowB(ctx context.Context, myid int64, otherid int6
 	}
 
 	// 删除关注记录（执行取关操作）
-	if err := r.data.DB.WithContext(ctx).
-		Table("follows").
-		Where("follower_id = ? AND followee_id = ?", myid, otherid).
-		Delete(nil).Error; err != nil {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Table("follows").
+			Where("follower_id = ? AND followee_id = ?", myid, otherid).
+			Delete(nil)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return followEvent(tx, biz.EventUserUnfollowed, myid, otherid)
+	})
+	if err != nil {
 		r.log.Errorf("AUnFollowB delete error: %v", err)
 		return err
 	}
@@ -198,6 +233,22 @@ func (r *RealWorldRepo) AUnFollowB(ctx context.Context, myid int64, otherid int6
 	return nil
 }
 
+func (r *RealWorldRepo) ListFollowers(ctx context.Context, myid int64, userID int64, p *biz.Page) ([]*biz.Follower, error) {
+	var list []*biz.Follower
+	db := r.data.DB.WithContext(ctx).
+		Table("follows fl").
+		Joins("JOIN users u ON u.id = fl.follower_id").
+		Select(`u.id, u.username AS user_name, COALESCE(u.bio, '') AS bio, COALESCE(u.image, '') AS image,
+			EXISTS (SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = u.id) AS following,
+			fl.created_at AS followed_at`, myid).
+		Where("fl.followee_id = ?", userID)
+	if err := applyPage(db, p, "fl.created_at", "fl.follower_id").Scan(&list).Error; err != nil {
+		r.log.Errorf("ListFollowers error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
 func (r *RealWorldRepo) ListByHello(context.Context, string) ([]*biz.RealWorld, error) {
 	return nil, nil
 }
@@ -233,21 +284,25 @@ func (r *RealWorldRepo) CreateUser(ctx context.Context, g *biz.RealWorld) (*biz.
 	return g, nil
 }
 
-func (r *RealWorldRepo) SetUserOnline(ctx context.Context, id int64) error {
-	key := fmt.Sprintf("user:online:%d", id)
-	if err := r.data.RDB.Set(ctx, key, "true", 30*time.Minute).Err(); err != nil {
-		return err
-	}
-	return nil
-}
-
 func (r *RealWorldRepo) CreateArticle(ctx context.Context, art *biz.Article) (*biz.Article, error) {
-	res := r.data.DB.WithContext(ctx).Create(art)
-	if res.Error != nil {
-		r.log.Errorf("CreateTag error: %v", res.Error)
-		return nil, res.Error
+	// 创建时记下第一个版本，后续的修改才能和它做 diff
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(art).Error; err != nil {
+			return err
+		}
+		if err := recordRevision(tx, art, art.AuthorID, nil); err != nil {
+			return err
+		}
+		return articleEvent(tx, biz.EventArticleCreated, art)
+	})
+	if isUniqueViolation(err, articlesSlugKey) {
+		return nil, biz.ErrSlugTaken
+	}
+	if err != nil {
+		r.log.Errorf("CreateArticle error: %v", err)
+		return nil, err
 	}
-	return art, nil //data层还未实现
+	return art, nil
 }
 func (r *RealWorldRepo) CreateTag(ctx context.Context, tag *biz.Tags) error {
 	// 使用 FirstOrCreate 检查标签是否已经存在
@@ -275,27 +330,91 @@ func (r *RealWorldRepo) CreateTags(ctx context.Context, tags *[]biz.Tags) error
 	return end_err //data层还未实现
 }
 
+// LinkArticleTags 写入文章与标签的多对多关系，tags 需要已经带上 id
+func (r *RealWorldRepo) LinkArticleTags(ctx context.Context, articleID int64, tags *[]biz.Tags) error {
+	for _, tag := range *tags {
+		if tag.ID == 0 {
+			continue
+		}
+		if err := r.data.DB.WithContext(ctx).Exec(
+			"INSERT INTO article_tags (article_id, tag_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+			articleID, tag.ID).Error; err != nil {
+			r.log.Errorf("LinkArticleTags error: %v", err)
+			return err
+		}
+	}
+	if len(*tags) > 0 {
+		// 缓存失效失败只影响推荐的新鲜度，等过期即可
+		if err := dropRelatedCaches(ctx, r.data, articleID); err != nil {
+			r.log.Warnf("LinkArticleTags drop related caches error: %v", err)
+		}
+	}
+	return nil
+}
+
+// loadTagLists 批量查询文章的标签列表，key 为文章 id
+func loadTagLists(ctx context.Context, db *gorm.DB, ids []int64) (map[int64][]string, error) {
+	res := make(map[int64][]string, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	var rows []struct {
+		ArticleID int64
+		Name      string
+	}
+	if err := db.WithContext(ctx).Raw(`
+		SELECT at.article_id, t.name
+		FROM article_tags at
+		JOIN tags t ON t.id = at.tag_id
+		WHERE at.article_id IN ?
+		ORDER BY t.name`, ids).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		res[row.ArticleID] = append(res[row.ArticleID], row.Name)
+	}
+	return res, nil
+}
+
 func (r *RealWorldRepo) GetArticleBySlug(ctx context.Context, slug string) (*biz.Article, error) {
 	var art biz.Article
 	res := r.data.DB.WithContext(ctx).Where("slug = ?", slug).First(&art)
+	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		// 标题修改后旧链接仍然可用
+		return r.findArticleBySlugHistory(ctx, slug)
+	}
 	if res.Error != nil {
 		return nil, res.Error
 	}
 	return &art, nil
 }
-func (r *RealWorldRepo) UpdateArticle(ctx context.Context, up *biz.Article) (*biz.Article, error) {
+func (r *RealWorldRepo) UpdateArticle(ctx context.Context, up *biz.Article, fields []string) (*biz.Article, error) {
 	upData := map[string]interface{}{}
 	if up.ID == 0 {
 		return nil, fmt.Errorf("cant found the atrticle id")
 	}
-	if up.Title != "" {
-		upData["title"] = up.Title
-	}
-	if up.Body != "" {
-		upData["body"] = up.Body
+	if fields == nil {
+		if up.Title != "" {
+			upData["title"] = up.Title
+		}
+		if up.Body != "" {
+			upData["body"] = up.Body
+		}
+		if up.Description != "" {
+			upData["description"] = up.Description
+		}
 	}
-	if up.Description != "" {
-		upData["description"] = up.Description
+	// 带了 update_mask 时按 mask 更新，空值也写入
+	for _, f := range fields {
+		switch f {
+		case "title":
+			upData["title"] = up.Title
+		case "description":
+			upData["description"] = up.Description
+		case "body":
+			upData["body"] = up.Body
+		}
 	}
 
 	if len(upData) == 0 {
@@ -303,19 +422,58 @@ func (r *RealWorldRepo) UpdateArticle(ctx context.Context, up *biz.Article) (*bi
 	}
 
 	//return nil, fmt.Errorf("ceshi")
-	res := r.data.DB.WithContext(ctx).Model(&biz.Article{}).Where("id = ?", up.ID).Updates(upData)
-
-	if res.Error != nil {
-		return nil, res.Error
+	if _, ok := upData["body"]; ok {
+		upData["word_count"] = up.WordCount
+		upData["reading_minutes"] = up.ReadingMinutes
+		upData["toc"] = up.TOC
 	}
+	upData["version"] = gorm.Expr("version + 1")
 
-	if res.RowsAffected == 0 {
-		return nil, errors.New("no article updated")
-	}
-	// 重新查询更新后的文章
+	// 更新文章和记录修订放在同一个事务里，up.AuthorID 是发起修改的用户
 	var updatedArticle biz.Article
-	if err := r.data.DB.WithContext(ctx).First(&updatedArticle, up.ID).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch updated article: %v", err)
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// slug 变化时旧 slug 记入历史，锁住文章行避免并发改名
+		var current string
+		if err := tx.Raw("SELECT slug FROM articles WHERE id = ? FOR UPDATE", up.ID).Scan(&current).Error; err != nil {
+			return err
+		}
+		if up.Slug != "" && up.Slug != current {
+			upData["slug"] = up.Slug
+			if err := moveSlug(tx, up.ID, current, up.Slug); err != nil {
+				return err
+			}
+		}
+		db := tx.Model(&biz.Article{}).Where("id = ?", up.ID)
+		if up.Version > 0 {
+			// 读取之后被别人改过就不会命中
+			db = db.Where("version = ?", up.Version)
+		}
+		res := db.Updates(upData)
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			if up.Version > 0 {
+				return biz.ErrVersionConflict
+			}
+			return errors.New("no article updated")
+		}
+		// 重新查询更新后的文章
+		if err := tx.First(&updatedArticle, up.ID).Error; err != nil {
+			return fmt.Errorf("failed to fetch updated article: %v", err)
+		}
+		if err := recordRevision(tx, &updatedArticle, up.AuthorID, up.RestoredFrom); err != nil {
+			return err
+		}
+		return articleEvent(tx, biz.EventArticleUpdated, &updatedArticle)
+	})
+	if isUniqueViolation(err, articlesSlugKey) {
+		return nil, biz.ErrSlugTaken
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &updatedArticle, nil
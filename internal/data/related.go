@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+type RelatedRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRelatedRepo .
+func NewRelatedRepo(data *Data, logger log.Logger) biz.RelatedRepo {
+	return &RelatedRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func relatedKey(id int64) string {
+	return fmt.Sprintf("article:related:%d", id)
+}
+
+func (r *RelatedRepo) GetRelatedIDs(ctx context.Context, articleID int64) ([]int64, bool, error) {
+	b, err := r.data.RDB.Get(ctx, relatedKey(articleID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var ids []int64
+	if err := json.Unmarshal(b, &ids); err != nil {
+		return nil, false, err
+	}
+	return ids, true, nil
+}
+
+func (r *RelatedRepo) SaveRelatedIDs(ctx context.Context, articleID int64, ids []int64, ttl time.Duration) error {
+	if ids == nil {
+		ids = []int64{}
+	}
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	return r.data.RDB.Set(ctx, relatedKey(articleID), b, ttl).Err()
+}
+
+// relatedScores 与原文有共同标签、同一作者或全文匹配的已发布文章及其得分
+// 原文的标题和摘要拆成词元后用 | 连接，任意一个词命中即可参与 ts_rank 排序
+const relatedScores = `
+	WITH src AS (
+		SELECT a.id, a.author_id,
+			to_tsquery('simple', array_to_string(ARRAY(
+				SELECT quote_literal(l)
+				FROM unnest(tsvector_to_array(to_tsvector('english',
+					a.title || ' ' || COALESCE(a.description, '')))) l
+			), ' | ')) AS q
+		FROM articles a WHERE a.id = ?
+	), shared AS (
+		SELECT at2.article_id, COUNT(*) AS n
+		FROM article_tags at1
+		JOIN article_tags at2 ON at2.tag_id = at1.tag_id AND at2.article_id <> at1.article_id
+		WHERE at1.article_id = ?
+		GROUP BY at2.article_id
+	)
+	SELECT a.id,
+		COALESCE(s.n, 0) * ?::float8
+			+ CASE WHEN a.author_id = src.author_id THEN ?::float8 ELSE 0 END
+			+ ts_rank(a.search_vector, src.q) * ?::float8 AS score
+	FROM articles a
+	CROSS JOIN src
+	LEFT JOIN shared s ON s.article_id = a.id
+	WHERE a.id <> src.id
+		AND a.status = ?
+		AND (s.n IS NOT NULL OR a.author_id = src.author_id OR a.search_vector @@ src.q)`
+
+func (r *RelatedRepo) ComputeRelated(ctx context.Context, articleID int64, w biz.RelatedWeights, limit int) ([]int64, error) {
+	var ids []int64
+	err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT id FROM (`+relatedScores+`) related
+		ORDER BY score DESC, id DESC
+		LIMIT ?`,
+		articleID, articleID, w.Tag, w.Author, w.Text, biz.ArticleStatusPublished, limit).
+		Scan(&ids).Error
+	if err != nil {
+		r.log.Errorf("ComputeRelated error: %v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *RelatedRepo) ListRelatedArticles(ctx context.Context, myid int64, ids []int64) ([]*biz.ArticleView, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	// 缓存期间文章可能被撤回或删除，作者也可能拉黑了读者，这里按最新状态再过滤一次
+	var rows []*biz.ArticleView
+	err := r.data.DB.WithContext(ctx).
+		Table("articles a").
+		Joins("JOIN users u ON u.id = a.author_id").
+		Select(articleViewColumns, myid, myid).
+		Where("a.id IN ?", ids).
+		Where("a.status = ?", biz.ArticleStatusPublished).
+		Where("u.suspended_at IS NULL").
+		Where("NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = ? AND b.blocked_id = u.id)", myid).
+		Where("NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = u.id AND b.blocked_id = ?)", myid).
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("ListRelatedArticles error: %v", err)
+		return nil, err
+	}
+
+	byID := make(map[int64]*biz.ArticleView, len(rows))
+	for _, a := range rows {
+		byID[a.ID] = a
+	}
+	list := make([]*biz.ArticleView, 0, len(rows))
+	kept := make([]int64, 0, len(rows))
+	for _, id := range ids {
+		if a, ok := byID[id]; ok {
+			list = append(list, a)
+			kept = append(kept, id)
+		}
+	}
+	tags, err := loadTagLists(ctx, r.data.DB, kept)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range list {
+		a.TagList = tags[a.ID]
+	}
+	return list, nil
+}
+
+// dropRelatedCaches 文章的标签变化后，它自己和所有与它有共同标签的文章的相关推荐都要重新计算
+func dropRelatedCaches(ctx context.Context, data *Data, articleID int64) error {
+	var ids []int64
+	if err := data.DB.WithContext(ctx).Raw(`
+		SELECT DISTINCT at2.article_id
+		FROM article_tags at1
+		JOIN article_tags at2 ON at2.tag_id = at1.tag_id
+		WHERE at1.article_id = ?`, articleID).
+		Scan(&ids).Error; err != nil {
+		return err
+	}
+	keys := []string{relatedKey(articleID)}
+	for _, id := range ids {
+		if id != articleID {
+			keys = append(keys, relatedKey(id))
+		}
+	}
+	return data.RDB.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// revisionViewColumns 修订列表的公共列；编辑者被删除后 editor_id 为空
+const revisionViewColumns = `r.id, r.article_id, r.revision, r.title, r.description, r.body,
+	COALESCE(r.editor_id, 0) AS editor_id, r.restored_from, r.created_at,
+	COALESCE(u.username, '') AS editor_name, COALESCE(u.bio, '') AS editor_bio, COALESCE(u.image, '') AS editor_image`
+
+type RevisionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRevisionRepo .
+func NewRevisionRepo(data *Data, logger log.Logger) biz.RevisionRepo {
+	return &RevisionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// recordRevision 把文章当前内容记为下一个版本，必须和修改文章的语句在同一事务里。
+// 修改文章时已经锁住了文章行，这里取 MAX(revision)+1 不会和并发更新冲突
+func recordRevision(tx *gorm.DB, art *biz.Article, editorID int64, restoredFrom *int32) error {
+	return tx.Exec(`INSERT INTO article_revisions (article_id, revision, title, description, body, editor_id, restored_from, created_at)
+		SELECT ?, COALESCE(MAX(revision), 0) + 1, ?, ?, ?, ?, ?, ?
+		FROM article_revisions WHERE article_id = ?`,
+		art.ID, art.Title, art.Description, art.Body, editorID, restoredFrom, time.Now(), art.ID).Error
+}
+
+func (r *RevisionRepo) revisionViews(ctx context.Context) *gorm.DB {
+	return r.data.DB.WithContext(ctx).
+		Table("article_revisions r").
+		Joins("LEFT JOIN users u ON u.id = r.editor_id").
+		Select(revisionViewColumns)
+}
+
+func (r *RevisionRepo) ListRevisions(ctx context.Context, articleID int64, p *biz.Page) ([]*biz.RevisionView, error) {
+	var list []*biz.RevisionView
+	db := r.revisionViews(ctx).Where("r.article_id = ?", articleID)
+	if err := applyPage(db, p, "r.created_at", "r.id").Scan(&list).Error; err != nil {
+		r.log.Errorf("ListRevisions error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *RevisionRepo) GetRevision(ctx context.Context, articleID int64, revision int32) (*biz.RevisionView, error) {
+	var rev biz.RevisionView
+	res := r.revisionViews(ctx).
+		Where("r.article_id = ? AND r.revision = ?", articleID, revision).
+		Take(&rev)
+	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrRevisionNotFound
+	}
+	if res.Error != nil {
+		r.log.Errorf("GetRevision error: %v", res.Error)
+		return nil, res.Error
+	}
+	return &rev, nil
+}
@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type ScheduleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewScheduleRepo .
+func NewScheduleRepo(data *Data, logger log.Logger) biz.ScheduleRepo {
+	return &ScheduleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ScheduleRepo) SetArticleSchedule(ctx context.Context, id int64, at *time.Time) (*biz.Article, error) {
+	if err := r.data.DB.WithContext(ctx).
+		Model(&biz.Article{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"scheduled_at": at, "updated_at": time.Now(), "version": gorm.Expr("version + 1")}).Error; err != nil {
+		r.log.Errorf("SetArticleSchedule error: %v", err)
+		return nil, err
+	}
+	var art biz.Article
+	if err := r.data.DB.WithContext(ctx).First(&art, id).Error; err != nil {
+		return nil, err
+	}
+	return &art, nil
+}
+
+func (r *ScheduleRepo) ListDueArticleIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	if err := r.data.DB.WithContext(ctx).
+		Model(&biz.Article{}).
+		Where("status = ? AND scheduled_at <= ?", biz.ArticleStatusDraft, now).
+		Order("scheduled_at").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		r.log.Errorf("ListDueArticleIDs error: %v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *ScheduleRepo) PublishScheduledArticle(ctx context.Context, id int64, now time.Time) (*biz.Article, error) {
+	// 发布时间记为预定时间，而不是任务实际跑到的时间；scheduled_at 带时区，写入 published_at 时按会话时区换算，和 NOW() 一致
+	var arts []*biz.Article
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw(`
+			UPDATE articles
+			SET status = ?, published_at = COALESCE(published_at, scheduled_at), scheduled_at = NULL, updated_at = ?,
+			    version = version + 1
+			WHERE id = ? AND status = ? AND scheduled_at <= ?
+			RETURNING *`,
+			biz.ArticleStatusPublished, now, id, biz.ArticleStatusDraft, now).
+			Scan(&arts).Error; err != nil {
+			return err
+		}
+		if len(arts) == 0 {
+			return nil
+		}
+		return articleEvent(tx, biz.EventArticleStatusChanged, arts[0])
+	})
+	if err != nil {
+		r.log.Errorf("PublishScheduledArticle error: %v", err)
+		return nil, err
+	}
+	if len(arts) == 0 {
+		return nil, nil
+	}
+	return arts[0], nil
+}
@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"html"
+	"strings"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type SearchRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSearchRepo .
+func NewSearchRepo(data *Data, logger log.Logger) biz.SearchRepo {
+	return &SearchRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ts_headline 先用控制字符标出命中词，转义 HTML 后再换成 <mark>，正文里的标签不会原样输出
+const (
+	highlightStart = "\x01"
+	highlightStop  = "\x02"
+)
+
+var highlightMarks = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// highlightHTML 把 ts_headline 的结果转成可以直接渲染的 HTML
+func highlightHTML(s string) string {
+	return highlightMarks.Replace(html.EscapeString(s))
+}
+
+// escapeLike 转义 LIKE 中的通配符，避免用户输入的 % 和 _ 被当作模式
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// profileHitColumns 搜索结果的公共列，第一个参数是当前用户 id
+const profileHitColumns = `
+	u.id, u.username AS user_name, COALESCE(u.bio, '') AS bio, COALESCE(u.image, '') AS image,
+	EXISTS (SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = u.id) AS following,
+	(SELECT COUNT(*) FROM follows f WHERE f.followee_id = u.id) AS followers_count`
+
+func (r *SearchRepo) SearchUsers(ctx context.Context, myid int64, q string, limit, offset int) ([]*biz.ProfileHit, error) {
+	var hits []*biz.ProfileHit
+	// username % q 和 q <% bio 走 pg_trgm 的 GIN 索引
+	err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT`+profileHitColumns+`
+		FROM users u
+		WHERE u.suspended_at IS NULL
+			AND (u.username % ? OR u.username ILIKE ? OR ? <% u.bio)
+		ORDER BY followers_count DESC,
+			GREATEST(similarity(u.username, ?), word_similarity(?, COALESCE(u.bio, ''))) DESC,
+			u.id
+		LIMIT ? OFFSET ?`,
+		myid, q, "%"+escapeLike(q)+"%", q, q, q, limit, offset).
+		Scan(&hits).Error
+	if err != nil {
+		r.log.Errorf("SearchUsers error: %v", err)
+		return nil, err
+	}
+	return hits, nil
+}
+
+func (r *SearchRepo) AutocompleteUsers(ctx context.Context, myid int64, prefix string, limit int) ([]*biz.ProfileHit, error) {
+	var hits []*biz.ProfileHit
+	err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT`+profileHitColumns+`
+		FROM users u
+		WHERE u.suspended_at IS NULL
+			AND u.username ILIKE ?
+		ORDER BY followers_count DESC, length(u.username), u.id
+		LIMIT ?`,
+		myid, escapeLike(prefix)+"%", limit).
+		Scan(&hits).Error
+	if err != nil {
+		r.log.Errorf("AutocompleteUsers error: %v", err)
+		return nil, err
+	}
+	return hits, nil
+}
+
+// articleSearchFilter 全文检索和标签、作者过滤，参数依次为 query、tag、tag、author、author
+const articleSearchFilter = `
+	FROM articles a
+	JOIN users u ON u.id = a.author_id
+	WHERE a.status = 'published'
+		AND a.search_vector @@ websearch_to_tsquery('english', ?)
+		AND (? = '' OR EXISTS (
+			SELECT 1 FROM article_tags at JOIN tags t ON t.id = at.tag_id
+			WHERE at.article_id = a.id AND t.name = ?))
+		AND (? = '' OR u.username = ?)`
+
+func (r *SearchRepo) SearchArticles(ctx context.Context, myid int64, s *biz.ArticleSearch) ([]*biz.ArticleHit, int64, error) {
+	filterArgs := []interface{}{s.Query, s.Tag, s.Tag, s.Author, s.Author}
+
+	var total int64
+	if err := r.data.DB.WithContext(ctx).
+		Raw(`SELECT COUNT(*)`+articleSearchFilter, filterArgs...).
+		Scan(&total).Error; err != nil {
+		r.log.Errorf("SearchArticles count error: %v", err)
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	// 先按相关度取出当前页，再只对这一页生成高亮，ts_headline 开销较大
+	args := append([]interface{}{s.Query}, filterArgs...)
+	titleOpts := "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", HighlightAll=true"
+	snippetOpts := "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", MaxFragments=2, MaxWords=30, MinWords=10"
+	args = append(args, s.Limit, s.Offset, s.Query, titleOpts, s.Query, snippetOpts, myid, myid)
+	var hits []*biz.ArticleHit
+	err := r.data.DB.WithContext(ctx).Raw(`
+		WITH page AS (
+			SELECT a.id, u.id AS author_id,
+				ts_rank_cd(a.search_vector, websearch_to_tsquery('english', ?)) AS rank
+			`+articleSearchFilter+`
+			ORDER BY rank DESC, a.created_at DESC, a.id DESC
+			LIMIT ? OFFSET ?
+		)
+		SELECT a.id, a.slug, a.title, a.description, a.author_id, a.created_at, a.updated_at, p.rank,
+			ts_headline('english', translate(a.title, chr(1) || chr(2), ''),
+				websearch_to_tsquery('english', ?), ?) AS title_highlight,
+			ts_headline('english', translate(COALESCE(a.body, ''), chr(1) || chr(2), ''),
+				websearch_to_tsquery('english', ?), ?) AS snippet,
+			u.username AS author_name, COALESCE(u.bio, '') AS author_bio, COALESCE(u.image, '') AS author_image,
+			EXISTS (SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = u.id) AS following,
+			EXISTS (SELECT 1 FROM favorites fav WHERE fav.user_id = ? AND fav.article_id = a.id) AS favorited,
+			(SELECT COUNT(*) FROM favorites fav WHERE fav.article_id = a.id) AS favorites_count
+		FROM page p
+		JOIN articles a ON a.id = p.id
+		JOIN users u ON u.id = p.author_id
+		ORDER BY p.rank DESC, a.created_at DESC, a.id DESC`, args...).
+		Scan(&hits).Error
+	if err != nil {
+		r.log.Errorf("SearchArticles error: %v", err)
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+	tags, err := loadTagLists(ctx, r.data.DB, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, h := range hits {
+		h.TagList = tags[h.ID]
+		h.TitleHighlight = highlightHTML(h.TitleHighlight)
+		h.Snippet = highlightHTML(h.Snippet)
+	}
+	return hits, total, nil
+}
@@ -0,0 +1,61 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// articlesSlugKey articles.slug 上唯一约束的名字
+const articlesSlugKey = "articles_slug_key"
+
+// isUniqueViolation 判断 err 是否违反了指定的唯一约束
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
+func (r *RealWorldRepo) TakenSlugs(ctx context.Context, base string, articleID int64) ([]string, error) {
+	// 历史 slug 也要避开，否则旧链接会跳到别人的文章
+	pattern := escapeLike(base) + "-%"
+	var slugs []string
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT slug FROM articles WHERE id <> ? AND (slug = ? OR slug LIKE ?)
+		UNION
+		SELECT slug FROM slug_history WHERE article_id <> ? AND (slug = ? OR slug LIKE ?)`,
+		articleID, base, pattern, articleID, base, pattern).
+		Scan(&slugs).Error; err != nil {
+		r.log.Errorf("TakenSlugs error: %v", err)
+		return nil, err
+	}
+	return slugs, nil
+}
+
+// moveSlug 把文章的旧 slug 记入历史；文章改回自己用过的 slug 时删掉那条历史
+func moveSlug(tx *gorm.DB, articleID int64, from, to string) error {
+	if err := tx.Exec(`INSERT INTO slug_history (slug, article_id, created_at) VALUES (?, ?, NOW())
+		ON CONFLICT (slug) DO UPDATE SET article_id = EXCLUDED.article_id, created_at = EXCLUDED.created_at`,
+		from, articleID).Error; err != nil {
+		return err
+	}
+	return tx.Exec("DELETE FROM slug_history WHERE slug = ? AND article_id = ?", to, articleID).Error
+}
+
+// findArticleBySlugHistory 按历史 slug 查找文章
+func (r *RealWorldRepo) findArticleBySlugHistory(ctx context.Context, slug string) (*biz.Article, error) {
+	var art biz.Article
+	res := r.data.DB.WithContext(ctx).
+		Where("id = (SELECT article_id FROM slug_history WHERE slug = ?)", slug).
+		Take(&art)
+	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrArticleNotFound
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return &art, nil
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// 关注的人所关注的用户，每条路径计 1 分
+	friendOfFriendWeight = 1.0
+	// 收藏过的文章作者，每篇计 2 分
+	favoriteAuthorWeight = 2.0
+)
+
+type SuggestionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSuggestionRepo .
+func NewSuggestionRepo(data *Data, logger log.Logger) biz.SuggestionRepo {
+	return &SuggestionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func suggestionKey(id int64) string {
+	return fmt.Sprintf("user:suggestions:%d", id)
+}
+
+func (r *SuggestionRepo) ListActiveUserIDs(ctx context.Context, after int64, limit int) ([]int64, error) {
+	var ids []int64
+	err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT id FROM (
+			SELECT follower_id AS id FROM follows
+			UNION
+			SELECT user_id AS id FROM favorites
+		) active
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?`, after, limit).
+		Scan(&ids).Error
+	if err != nil {
+		r.log.Errorf("ListActiveUserIDs error: %v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *SuggestionRepo) ComputeSuggestions(ctx context.Context, id int64, limit int) ([]*biz.Suggestion, error) {
+	var list []*biz.Suggestion
+	// 候选人：好友的好友 + 收藏文章的作者；排除自己、已关注以及双向拉黑的用户
+	err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT u.id AS user_id, u.username AS user_name, COALESCE(u.bio, '') AS bio,
+			COALESCE(u.image, '') AS image, c.score
+		FROM (
+			SELECT candidate, SUM(weight) AS score FROM (
+				SELECT f2.followee_id AS candidate, ? AS weight
+				FROM follows f1
+				JOIN follows f2 ON f2.follower_id = f1.followee_id
+				WHERE f1.follower_id = ?
+				UNION ALL
+				SELECT a.author_id AS candidate, ? AS weight
+				FROM favorites fav
+				JOIN articles a ON a.id = fav.article_id
+				WHERE fav.user_id = ?
+			) paths
+			GROUP BY candidate
+		) c
+		JOIN users u ON u.id = c.candidate
+		WHERE c.candidate <> ?
+			AND u.suspended_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM follows f WHERE f.follower_id = ? AND f.followee_id = c.candidate)
+			AND NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = ? AND b.blocked_id = c.candidate)
+			AND NOT EXISTS (SELECT 1 FROM blocks b WHERE b.blocker_id = c.candidate AND b.blocked_id = ?)
+		ORDER BY c.score DESC, u.id
+		LIMIT ?`,
+		friendOfFriendWeight, id, favoriteAuthorWeight, id,
+		id, id, id, id, limit).
+		Scan(&list).Error
+	if err != nil {
+		r.log.Errorf("ComputeSuggestions error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SuggestionRepo) SaveSuggestions(ctx context.Context, id int64, list []*biz.Suggestion, ttl time.Duration) error {
+	if list == nil {
+		list = []*biz.Suggestion{}
+	}
+	b, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return r.data.RDB.Set(ctx, suggestionKey(id), b, ttl).Err()
+}
+
+func (r *SuggestionRepo) GetSuggestions(ctx context.Context, id int64) ([]*biz.Suggestion, error) {
+	b, err := r.data.RDB.Get(ctx, suggestionKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// 还没有预计算过
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.Suggestion
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *SuggestionRepo) DropSuggestion(ctx context.Context, id int64, otherid int64) error {
+	list, err := r.GetSuggestions(ctx, id)
+	if err != nil || len(list) == 0 {
+		return err
+	}
+	kept := list[:0]
+	for _, s := range list {
+		if s.UserID != otherid {
+			kept = append(kept, s)
+		}
+	}
+	if len(kept) == len(list) {
+		return nil
+	}
+	b, err := json.Marshal(kept)
+	if err != nil {
+		return err
+	}
+	return r.data.RDB.SetArgs(ctx, suggestionKey(id), b, redis.SetArgs{KeepTTL: true}).Err()
+}
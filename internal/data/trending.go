@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 有新访问、等待落库的 "文章id:日期" 集合
+const viewsDirtyKey = "article:views:dirty"
+
+const viewDayLayout = "20060102"
+
+type ViewRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewViewRepo .
+func NewViewRepo(data *Data, logger log.Logger) biz.ViewRepo {
+	return &ViewRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func viewsKey(id int64) string {
+	return fmt.Sprintf("article:views:%d", id)
+}
+
+func viewsDayKey(id int64, day string) string {
+	return fmt.Sprintf("article:views:%d:%s", id, day)
+}
+
+func (r *ViewRepo) RecordView(ctx context.Context, articleID int64, viewer string, at time.Time, keep time.Duration) error {
+	day := at.UTC().Format(viewDayLayout)
+	_, err := r.data.RDB.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.PFAdd(ctx, viewsKey(articleID), viewer)
+		pipe.PFAdd(ctx, viewsDayKey(articleID, day), viewer)
+		pipe.Expire(ctx, viewsDayKey(articleID, day), keep)
+		pipe.SAdd(ctx, viewsDirtyKey, fmt.Sprintf("%d:%s", articleID, day))
+		return nil
+	})
+	return err
+}
+
+type viewDay struct {
+	ArticleID int64
+	Day       time.Time
+	Views     int64
+}
+
+func (viewDay) TableName() string {
+	return "article_view_days"
+}
+
+func (r *ViewRepo) FlushViews(ctx context.Context, limit int) (int, error) {
+	members, err := r.data.RDB.SPopN(ctx, viewsDirtyKey, int64(limit)).Result()
+	if err != nil {
+		r.log.Errorf("FlushViews pop error: %v", err)
+		return 0, err
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+	err = r.flushViews(ctx, members)
+	if err != nil {
+		// 写库失败时放回集合，下一轮重试；HyperLogLog 本身没有被修改
+		if err := r.data.RDB.SAdd(ctx, viewsDirtyKey, members).Err(); err != nil {
+			r.log.Errorf("FlushViews requeue error: %v", err)
+		}
+		return 0, err
+	}
+	return len(members), nil
+}
+
+func (r *ViewRepo) flushViews(ctx context.Context, members []string) error {
+	type pending struct {
+		id  int64
+		day time.Time
+		cmd *redis.IntCmd
+	}
+	days := make([]pending, 0, len(members))
+	totals := make(map[int64]*redis.IntCmd)
+	_, err := r.data.RDB.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, m := range members {
+			idPart, dayPart, ok := strings.Cut(m, ":")
+			id, err := strconv.ParseInt(idPart, 10, 64)
+			if !ok || err != nil {
+				continue
+			}
+			day, err := time.Parse(viewDayLayout, dayPart)
+			if err != nil {
+				continue
+			}
+			days = append(days, pending{id: id, day: day, cmd: pipe.PFCount(ctx, viewsDayKey(id, dayPart))})
+			if _, ok := totals[id]; !ok {
+				totals[id] = pipe.PFCount(ctx, viewsKey(id))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("FlushViews count error: %v", err)
+		return err
+	}
+
+	ids := make([]int64, 0, len(totals))
+	for id := range totals {
+		ids = append(ids, id)
+	}
+	err = r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 文章可能已经删除，只写回还存在的文章
+		var existing []int64
+		if err := tx.Raw("SELECT id FROM articles WHERE id IN ?", ids).Scan(&existing).Error; err != nil {
+			return err
+		}
+		alive := make(map[int64]bool, len(existing))
+		for _, id := range existing {
+			alive[id] = true
+		}
+		rows := make([]viewDay, 0, len(days))
+		for _, d := range days {
+			if alive[d.id] {
+				rows = append(rows, viewDay{ArticleID: d.id, Day: d.day, Views: d.cmd.Val()})
+			}
+		}
+		if len(rows) > 0 {
+			// HyperLogLog 给出的是累计值，直接覆盖
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "article_id"}, {Name: "day"}},
+				DoUpdates: clause.AssignmentColumns([]string{"views"}),
+			}).Create(&rows).Error; err != nil {
+				return err
+			}
+		}
+		for _, id := range existing {
+			if err := tx.Exec("UPDATE articles SET views_count = ? WHERE id = ?", totals[id].Val(), id).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("FlushViews error: %v", err)
+	}
+	return err
+}
+
+// trendingScores 窗口内每篇文章的热度：每日阅读、收藏、评论各自按权重计分，并按距今时间指数衰减
+// 每日阅读的 day 是 UTC 日期，按 UTC 的当前时间算距今多久；收藏和评论的 created_at 由 NOW() 按会话时区写入，
+// 直接和 NOW() 相减，两边时区一致
+const trendingScores = `
+	SELECT article_id, SUM(score) AS score FROM (
+		SELECT article_id, views * ?::float8
+			* POWER(0.5, EXTRACT(EPOCH FROM ((NOW() AT TIME ZONE 'UTC') - day::timestamp))::float8 / ?::float8) AS score
+		FROM article_view_days WHERE day >= ((NOW() AT TIME ZONE 'UTC') - make_interval(secs => ?))::date
+		UNION ALL
+		SELECT article_id, ?::float8
+			* POWER(0.5, EXTRACT(EPOCH FROM (NOW() - created_at))::float8 / ?::float8)
+		FROM favorites WHERE created_at >= NOW() - make_interval(secs => ?)
+		UNION ALL
+		SELECT article_id, ?::float8
+			* POWER(0.5, EXTRACT(EPOCH FROM (NOW() - created_at))::float8 / ?::float8)
+		FROM comments WHERE created_at >= NOW() - make_interval(secs => ?)
+	) activity
+	GROUP BY article_id`
+
+func (r *ViewRepo) TrendingArticles(ctx context.Context, myid int64, q *biz.TrendingQuery) ([]*biz.ArticleView, int64, error) {
+	halfLife := q.HalfLife.Seconds()
+	window := q.Window.Seconds()
+	scoreArgs := []interface{}{
+		q.Weights.View, halfLife, window,
+		q.Weights.Favorite, halfLife, window,
+		q.Weights.Comment, halfLife, window,
+	}
+
+	var total int64
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT COUNT(*) FROM (`+trendingScores+`) s
+		JOIN articles a ON a.id = s.article_id
+		WHERE a.status = ?`, append(scoreArgs, biz.ArticleStatusPublished)...).
+		Scan(&total).Error; err != nil {
+		r.log.Errorf("TrendingArticles count error: %v", err)
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	args := append([]interface{}{myid, myid}, scoreArgs...)
+	args = append(args, biz.ArticleStatusPublished, q.Limit, q.Offset)
+	var list []*biz.ArticleView
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT `+articleViewColumns+`
+		FROM (`+trendingScores+`) s
+		JOIN articles a ON a.id = s.article_id
+		JOIN users u ON u.id = a.author_id
+		WHERE a.status = ?
+		ORDER BY s.score DESC, a.id DESC
+		LIMIT ? OFFSET ?`, args...).
+		Scan(&list).Error; err != nil {
+		r.log.Errorf("TrendingArticles error: %v", err)
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(list))
+	for _, a := range list {
+		ids = append(ids, a.ID)
+	}
+	tags, err := loadTagLists(ctx, r.data.DB, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, a := range list {
+		a.TagList = tags[a.ID]
+	}
+	return list, total, nil
+}
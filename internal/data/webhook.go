@@ -0,0 +1,305 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	// 待投递的 id，score 为下次尝试的毫秒时间戳；领取时把 score 推到租约结束
+	webhookQueueKey = "webhook:queue"
+	// 放弃投递的 id，最新的在前
+	webhookDeadKey = "webhook:dead"
+	// 死信列表保留的条数，完整记录在数据库里
+	webhookDeadLen = 1000
+	// 读取的响应体上限，只用来让连接可以复用
+	webhookMaxResponse = 64 << 10
+)
+
+// claimWebhooks 取出到期的 id 并把它们的 score 推到租约结束，原子执行保证多个实例不会领到同一个 id
+var claimWebhooks = redis.NewScript(`
+local ids = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "LIMIT", 0, ARGV[3])
+for _, id in ipairs(ids) do
+	redis.call("ZADD", KEYS[1], "XX", ARGV[2], id)
+end
+return ids`)
+
+type WebhookRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewWebhookRepo .
+func NewWebhookRepo(data *Data, logger log.Logger) biz.WebhookRepo {
+	return &WebhookRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *WebhookRepo) CreateWebhook(ctx context.Context, w *biz.Webhook) (*biz.Webhook, error) {
+	if err := r.data.DB.WithContext(ctx).Create(w).Error; err != nil {
+		r.log.Errorf("CreateWebhook error: %v", err)
+		return nil, err
+	}
+	return w, nil
+}
+
+func (r *WebhookRepo) ListWebhooks(ctx context.Context, userID int64) ([]*biz.Webhook, error) {
+	var list []*biz.Webhook
+	if err := r.data.DB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&list).Error; err != nil {
+		r.log.Errorf("ListWebhooks error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *WebhookRepo) CountWebhooks(ctx context.Context, userID int64) (int64, error) {
+	var n int64
+	if err := r.data.DB.WithContext(ctx).Model(&biz.Webhook{}).Where("user_id = ?", userID).Count(&n).Error; err != nil {
+		r.log.Errorf("CountWebhooks error: %v", err)
+		return 0, err
+	}
+	return n, nil
+}
+
+func (r *WebhookRepo) GetWebhook(ctx context.Context, id int64) (*biz.Webhook, error) {
+	var w biz.Webhook
+	err := r.data.DB.WithContext(ctx).First(&w, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("GetWebhook error: %v", err)
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *WebhookRepo) DeleteWebhook(ctx context.Context, id int64) error {
+	if err := r.data.DB.WithContext(ctx).Exec("DELETE FROM webhooks WHERE id = ?", id).Error; err != nil {
+		r.log.Errorf("DeleteWebhook error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *WebhookRepo) AddDeliveries(ctx context.Context, ownerID int64, event string, payload []byte, now time.Time) error {
+	var ids []int64
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		INSERT INTO webhook_deliveries (webhook_id, event, payload, next_attempt_at, created_at)
+		SELECT id, ?, ?, ?, ? FROM webhooks
+		WHERE (user_id = ? OR is_global) AND (events = '' OR ? = ANY(string_to_array(events, ',')))
+		RETURNING id`, event, string(payload), now, now, ownerID, event).
+		Scan(&ids).Error; err != nil {
+		r.log.Errorf("AddDeliveries error: %v", err)
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	members := make([]redis.Z, len(ids))
+	for i, id := range ids {
+		members[i] = redis.Z{Score: float64(now.UnixMilli()), Member: id}
+	}
+	// 记录已经落库，入队失败时由 RequeueStale 补回，这里不算失败
+	if err := r.data.RDB.ZAdd(ctx, webhookQueueKey, members...).Err(); err != nil {
+		r.log.Warnf("enqueue webhook deliveries error: %v", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepo) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]int64, error) {
+	res, err := claimWebhooks.Run(ctx, r.data.RDB, []string{webhookQueueKey},
+		now.UnixMilli(), now.Add(lease).UnixMilli(), limit).StringSlice()
+	if err != nil {
+		r.log.Errorf("ClaimDeliveries error: %v", err)
+		return nil, err
+	}
+	ids := make([]int64, 0, len(res))
+	for _, s := range res {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			r.log.Warnf("bad webhook queue member %q", s)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r *WebhookRepo) GetDelivery(ctx context.Context, id int64) (*biz.WebhookDelivery, error) {
+	var d biz.WebhookDelivery
+	res := r.data.DB.WithContext(ctx).Raw(`
+		SELECT d.*, w.url, w.secret
+		FROM webhook_deliveries d
+		JOIN webhooks w ON w.id = d.webhook_id
+		WHERE d.id = ?`, id).Scan(&d)
+	if res.Error != nil {
+		r.log.Errorf("GetDelivery error: %v", res.Error)
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &d, nil
+}
+
+func (r *WebhookRepo) SaveAttempt(ctx context.Context, d *biz.WebhookDelivery) error {
+	if err := r.data.DB.WithContext(ctx).Exec(`
+		UPDATE webhook_deliveries
+		SET status = ?, attempts = ?, response_status = ?, last_error = ?, next_attempt_at = ?, delivered_at = ?
+		WHERE id = ?`,
+		d.Status, d.Attempts, d.ResponseStatus, d.LastError, d.NextAttemptAt, d.DeliveredAt, d.ID).Error; err != nil {
+		r.log.Errorf("SaveAttempt error: %v", err)
+		return err
+	}
+	_, err := r.data.RDB.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		switch d.Status {
+		case biz.WebhookDeliveryPending:
+			pipe.ZAdd(ctx, webhookQueueKey, redis.Z{Score: float64(d.NextAttemptAt.UnixMilli()), Member: d.ID})
+		case biz.WebhookDeliveryDead:
+			pipe.ZRem(ctx, webhookQueueKey, d.ID)
+			pipe.LPush(ctx, webhookDeadKey, d.ID)
+			pipe.LTrim(ctx, webhookDeadKey, 0, webhookDeadLen-1)
+		default:
+			pipe.ZRem(ctx, webhookQueueKey, d.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		// 状态已经落库：pending 的由 RequeueStale 补回，其余的下次领取时出队
+		r.log.Warnf("update webhook queue error: %v", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepo) Dequeue(ctx context.Context, id int64) error {
+	if err := r.data.RDB.ZRem(ctx, webhookQueueKey, id).Err(); err != nil {
+		r.log.Errorf("Dequeue error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *WebhookRepo) RequeueStale(ctx context.Context, before time.Time, limit int) error {
+	var list []*biz.WebhookDelivery
+	if err := r.data.DB.WithContext(ctx).Raw(`
+		SELECT id, next_attempt_at FROM webhook_deliveries
+		WHERE status = ? AND next_attempt_at < ?
+		ORDER BY next_attempt_at
+		LIMIT ?`, biz.WebhookDeliveryPending, before, limit).
+		Scan(&list).Error; err != nil {
+		r.log.Errorf("RequeueStale error: %v", err)
+		return err
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	members := make([]redis.Z, len(list))
+	for i, d := range list {
+		members[i] = redis.Z{Score: float64(d.NextAttemptAt.UnixMilli()), Member: d.ID}
+	}
+	// NX：还在队列里（包括正被领取）的不动
+	if err := r.data.RDB.ZAddNX(ctx, webhookQueueKey, members...).Err(); err != nil {
+		r.log.Errorf("RequeueStale error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, webhookID int64, status string, p *biz.Page) ([]*biz.WebhookDelivery, error) {
+	db := r.data.DB.WithContext(ctx).
+		Table("webhook_deliveries").
+		Select(`id, webhook_id, event, status, attempts, response_status, last_error,
+			next_attempt_at, delivered_at, created_at`).
+		Where("webhook_id = ?", webhookID)
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	var list []*biz.WebhookDelivery
+	if err := applyPage(db, p, "created_at", "id").Scan(&list).Error; err != nil {
+		r.log.Errorf("ListDeliveries error: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+type WebhookSender struct {
+	client *http.Client
+}
+
+// NewWebhookSender .
+func NewWebhookSender(c *conf.Biz) biz.WebhookSender {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !c.GetWebhook().GetAllowPrivateTargets() {
+		// 按实际要连接的地址检查，注册之后 DNS 解析结果变成内网地址也连不上
+		dialer.Control = dialPublicOnly
+	}
+	return &WebhookSender{
+		client: &http.Client{
+			Transport: &http.Transport{
+				// 不走环境变量里的代理，否则连接的是代理地址，检查不到真正的目标
+				Proxy:                 nil,
+				DialContext:           dialer.DialContext,
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ExpectContinueTimeout: time.Second,
+			},
+			// 不跟随重定向，3xx 按失败处理，避免签名后的内容被转发到注册地址以外的地方
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !biz.PublicWebhookAddr(ap.Addr()) {
+		return fmt.Errorf("webhook target %s is not a public address", ap.Addr())
+	}
+	return nil
+}
+
+func (s *WebhookSender) Send(ctx context.Context, req *biz.WebhookRequest) (int, error) {
+	hr, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+	hr.Header.Set("User-Agent", "kratos-realworld-webhook")
+	for k, v := range req.Header {
+		hr.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(hr)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookMaxResponse))
+	return resp.StatusCode, nil
+}
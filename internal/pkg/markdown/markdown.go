@@ -0,0 +1,72 @@
+// Package markdown renders CommonMark with GitHub Flavored Markdown extensions into sanitized HTML.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"kratos-realworld/internal/pkg/slug"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+var (
+	md = goldmark.New(
+		// GFM：表格、删除线、自动链接、任务列表
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		// Markdown 里的原始 HTML 先保留，统一交给白名单过滤
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+	policy = newPolicy()
+)
+
+// newPolicy 在 UGC 白名单基础上放开代码高亮用的 class、标题锚点和任务列表的复选框
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\w-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// headingIDs 用 slug 生成标题锚点，中文标题也能得到可读的 id；同一文档内重复时加数字后缀
+type headingIDs struct {
+	used map[string]bool
+}
+
+func (s *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	base := slug.Make(string(value))
+	id := base
+	for n := 2; s.used[id]; n++ {
+		id = slug.WithSuffix(base, n)
+	}
+	s.used[id] = true
+	return []byte(id)
+}
+
+func (s *headingIDs) Put(value []byte) {
+	s.used[string(value)] = true
+}
+
+// newContext 每次解析都要新的 context，锚点去重只在一篇文档内
+func newContext() parser.Context {
+	return parser.NewContext(parser.WithIDs(&headingIDs{used: map[string]bool{}}))
+}
+
+// Render converts Markdown source into HTML that is safe to embed in a page.
+func Render(src string) (string, error) {
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(src), &buf, parser.WithContext(newContext())); err != nil {
+		return "", err
+	}
+	return policy.SanitizeReader(&buf).String(), nil
+}
@@ -0,0 +1,87 @@
+package markdown_test
+
+import (
+	"strings"
+	"testing"
+
+	"kratos-realworld/internal/pkg/markdown"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "emphasis",
+			src:  "Hello *world*",
+			want: []string{"<p>Hello <em>world</em></p>"},
+		},
+		{
+			name: "table",
+			src:  "| a | b |\n| - | - |\n| 1 | 2 |",
+			want: []string{"<table>", "<th>a</th>", "<td>2</td>"},
+		},
+		{
+			name: "strikethrough and task list",
+			src:  "- [x] ~~done~~\n- [ ] todo",
+			want: []string{"<del>done</del>", `type="checkbox"`, "checked"},
+		},
+		{
+			name: "code block language kept",
+			src:  "```go\nfmt.Println(1)\n```",
+			want: []string{`<code class="language-go">`},
+		},
+		{
+			name:    "script removed",
+			src:     "hi <script>alert(1)</script>",
+			notWant: []string{"<script", "alert(1)"},
+		},
+		{
+			name:    "event handlers removed",
+			src:     `<img src="x.png" onerror="alert(1)">`,
+			want:    []string{`src="x.png"`},
+			notWant: []string{"onerror"},
+		},
+		{
+			name:    "javascript links removed",
+			src:     "[click](javascript:alert(1))",
+			notWant: []string{"javascript:"},
+		},
+		{
+			name: "external links",
+			src:  "[site](https://example.com)",
+			want: []string{`href="https://example.com"`, `rel="nofollow noopener"`, `target="_blank"`},
+		},
+		{
+			name: "heading anchors",
+			src:  "# 你好 世界\n\n# Intro\n\n# Intro",
+			want: []string{`<h1 id="ni-hao-shi-jie">`, `<h1 id="intro">`, `<h1 id="intro-2">`},
+		},
+		{
+			name:    "arbitrary class dropped",
+			src:     `<code class="evil">x</code>`,
+			notWant: []string{"evil"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := markdown.Render(tt.src)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			for _, s := range tt.want {
+				if !strings.Contains(got, s) {
+					t.Errorf("Render(%q) = %q, want it to contain %q", tt.src, got, s)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(got, s) {
+					t.Errorf("Render(%q) = %q, want no %q", tt.src, got, s)
+				}
+			}
+		})
+	}
+}
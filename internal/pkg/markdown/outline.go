@@ -0,0 +1,105 @@
+package markdown
+
+import (
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Heading is an entry of a document outline. ID matches the anchor of the rendered heading.
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
+
+// Summary counts the readable text of a document and lists its headings.
+type Summary struct {
+	// Words 是以空白和标点分隔的词数，不含 CJK 字符
+	Words int
+	// CJKChars 是中日韩字符数，这些文字不用空格分词，按字计数
+	CJKChars int
+	Headings []Heading
+}
+
+// Analyze parses Markdown source and summarizes it.
+func Analyze(src string) Summary {
+	source := []byte(src)
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithContext(newContext()))
+	var s Summary
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *ast.Heading:
+			h := Heading{Level: node.Level, Text: plainText(node, source)}
+			if id, ok := node.AttributeString("id"); ok {
+				if b, ok := id.([]byte); ok {
+					h.ID = string(b)
+				}
+			}
+			s.Headings = append(s.Headings, h)
+		case *ast.Text:
+			s.count(node.Segment.Value(source))
+		case *ast.String:
+			s.count(node.Value)
+		case *ast.FencedCodeBlock, *ast.CodeBlock:
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				seg := lines.At(i)
+				s.count(seg.Value(source))
+			}
+		case *ast.HTMLBlock, *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return s
+}
+
+// count 连续的字母数字算一个词，CJK 字符每个算一个
+func (s *Summary) count(b []byte) {
+	inWord := false
+	for _, r := range string(b) {
+		switch {
+		case isCJK(r):
+			s.CJKChars++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || (inWord && (r == '\'' || r == '’')):
+			if !inWord {
+				s.Words++
+				inWord = true
+			}
+		default:
+			inWord = false
+		}
+	}
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// plainText 拼接节点下所有文本，去掉强调、链接等标记
+func plainText(n ast.Node, source []byte) string {
+	var b []byte
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch t := c.(type) {
+		case *ast.Text:
+			b = append(b, t.Segment.Value(source)...)
+			if t.SoftLineBreak() {
+				b = append(b, ' ')
+			}
+		case *ast.String:
+			b = append(b, t.Value...)
+		}
+		return ast.WalkContinue, nil
+	})
+	return string(b)
+}
@@ -0,0 +1,88 @@
+// Package slug turns arbitrary titles into short, URL-safe ASCII slugs.
+package slug
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mozillazg/go-unidecode"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxLength is the maximum length of a slug including its collision suffix.
+	MaxLength = 80
+	// 给冲突后缀（如 -12）预留的长度，加上后缀后不需要再截断
+	suffixReserve = 8
+	maxBaseLength = MaxLength - suffixReserve
+	// Fallback is used when nothing is left of the title, e.g. a title made of emoji.
+	Fallback = "untitled"
+)
+
+// Make returns the slug of s: Unicode is normalized and transliterated to ASCII,
+// everything except letters and digits becomes a single dash, and long slugs are
+// cut at a word boundary. It never returns an empty string.
+func Make(s string) string {
+	// NFKC 把全角字符、连字等兼容字符变成常规形式，再音译成 ASCII（中文转拼音、去掉变音符号）
+	s = unidecode.Unidecode(norm.NFKC.String(s))
+	var b strings.Builder
+	b.Grow(len(s))
+	dash := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			b.WriteByte(c)
+			dash = false
+		case c >= 'A' && c <= 'Z':
+			b.WriteByte(c + 'a' - 'A')
+			dash = false
+		case c == '\'' || c == '"':
+			// don't -> dont
+		default:
+			if !dash && b.Len() > 0 {
+				b.WriteByte('-')
+				dash = true
+			}
+		}
+	}
+	out := truncate(strings.TrimRight(b.String(), "-"), maxBaseLength)
+	if out == "" {
+		return Fallback
+	}
+	return out
+}
+
+// truncate 超长时尽量在单词边界截断，边界太靠前则直接截断
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	s = s[:max]
+	if i := strings.LastIndexByte(s, '-'); i > max/2 {
+		s = s[:i]
+	}
+	return strings.TrimRight(s, "-")
+}
+
+// WithSuffix returns base with a numeric collision suffix, e.g. hello-world-2.
+func WithSuffix(base string, n int) string {
+	return base + "-" + strconv.Itoa(n)
+}
+
+// Unique returns base if it is free, otherwise base with the smallest free suffix starting at 2.
+// taken holds the slugs already in use that start with base.
+func Unique(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		used[s] = true
+	}
+	if !used[base] {
+		return base
+	}
+	for n := 2; ; n++ {
+		if s := WithSuffix(base, n); !used[s] {
+			return s
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package slug_test
+
+import (
+	"strings"
+	"testing"
+
+	"kratos-realworld/internal/pkg/slug"
+)
+
+func TestMake(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Hello World", "hello-world"},
+		{"punctuation collapses", "  Hello,   World!!  ", "hello-world"},
+		{"apostrophes dropped", `Don't "quote" me`, "dont-quote-me"},
+		{"diacritics", "Crème Brûlée", "creme-brulee"},
+		{"full width", "ＨＥＬＬＯ　１２３", "hello-123"},
+		{"chinese", "你好 世界", "ni-hao-shi-jie"},
+		{"only emoji", "🎉🎉", slug.Fallback},
+		{"empty", "", slug.Fallback},
+		{"cut at word boundary", long, strings.TrimSuffix(strings.Repeat("word-", 14), "-")},
+		{"cut inside long word", strings.Repeat("a", 100), strings.Repeat("a", slug.MaxLength-8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slug.Make(tt.in)
+			if got != tt.want {
+				t.Errorf("Make(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if len(got) > slug.MaxLength {
+				t.Errorf("Make(%q) is %d bytes, longer than %d", tt.in, len(got), slug.MaxLength)
+			}
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  string
+		taken []string
+		want  string
+	}{
+		{"free", "hello", nil, "hello"},
+		{"only longer slugs taken", "hello", []string{"hello-world"}, "hello"},
+		{"base taken", "hello", []string{"hello"}, "hello-2"},
+		{"fills the first gap", "hello", []string{"hello", "hello-2", "hello-4"}, "hello-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slug.Unique(tt.base, tt.taken); got != tt.want {
+				t.Errorf("Unique(%q, %q) = %q, want %q", tt.base, tt.taken, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maskIgnoredFields 推断 update_mask 时跳过的字段：version 是并发控制的前置条件而不是数据，
+// email 和 password 不能通过资料更新修改，老客户端会整张表单一起提交
+var maskIgnoredFields = map[protoreflect.Name]bool{
+	"version":  true,
+	"email":    true,
+	"password": true,
+}
+
+// decodeRequest 在默认解码之后补全 update_mask。
+// JSON 里的 null 解码后和没传一样，客户端没有带 mask 时，把显式 null 和非空值的资源字段作为 mask：
+// "bio": null 清空 bio，"bio": "" 仍按老客户端的整表单提交处理，视为没传
+func decodeRequest(r *nethttp.Request, v interface{}) error {
+	if err := http.DefaultRequestDecoder(r, v); err != nil {
+		return err
+	}
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return inferUpdateMask(r, m.ProtoReflect())
+}
+
+func inferUpdateMask(r *nethttp.Request, m protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	maskField := fields.ByName("update_mask")
+	if maskField == nil || maskField.Message() == nil ||
+		maskField.Message().FullName() != "google.protobuf.FieldMask" || m.Has(maskField) {
+		return nil
+	}
+	data, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	// 默认解码器已经校验过请求体，这里解析失败直接忽略
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil
+	}
+	var paths []string
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd == maskField || fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			continue
+		}
+		raw, ok := body[fd.JSONName()]
+		if !ok {
+			raw, ok = body[string(fd.Name())]
+		}
+		if !ok {
+			continue
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			continue
+		}
+		resource := fd.Message().Fields()
+		for key, value := range obj {
+			if isEmptyJSONString(value) {
+				continue
+			}
+			f := resource.ByJSONName(key)
+			if f == nil {
+				f = resource.ByName(protoreflect.Name(key))
+			}
+			if f == nil || maskIgnoredFields[f.Name()] {
+				continue
+			}
+			paths = append(paths, string(f.Name()))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: paths}
+	m.Set(maskField, protoreflect.ValueOfMessage(mask.ProtoReflect()))
+	return nil
+}
+
+// isEmptyJSONString 值是否为 ""；null 解码后指针仍为 nil，不算空串
+func isEmptyJSONString(raw json.RawMessage) bool {
+	var s *string
+	return json.Unmarshal(raw, &s) == nil && s != nil && *s == ""
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	pb "kratos-realworld/api/realworld/v1"
+)
+
+func TestDecodeRequestInfersUpdateMask(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		// nil 表示没有推断出 mask
+		want []string
+	}{
+		{"absent fields", `{"user":{}}`, nil},
+		{"non-empty value", `{"user":{"bio":"hi"}}`, []string{"bio"}},
+		{"explicit null clears", `{"user":{"bio":null}}`, []string{"bio"}},
+		{"empty string is a full form", `{"user":{"bio":"","image":"a.png"}}`, []string{"image"}},
+		{"ignored fields", `{"user":{"email":"a@b.c","password":"x","version":"3"}}`, nil},
+		{"proto field names", `{"user":{"username":"bob","image":null}}`, []string{"image", "username"}},
+		{"explicit mask wins", `{"user":{"bio":"hi","image":"a.png"},"updateMask":"image"}`, []string{"image"}},
+		{"empty body", ``, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PUT", "/api/user", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			var req pb.UpdateUserRequest
+			if err := decodeRequest(r, &req); err != nil {
+				t.Fatalf("decodeRequest: %v", err)
+			}
+			got := req.GetUpdateMask().GetPaths()
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") || (got == nil) != (tt.want == nil) {
+				t.Errorf("update_mask = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeRequestWithoutUpdateMask(t *testing.T) {
+	r := httptest.NewRequest("POST", "/api/articles/a/comments", strings.NewReader(`{"comment":{"body":"hi"}}`))
+	r.Header.Set("Content-Type", "application/json")
+	var req pb.AddCommentsRequest
+	if err := decodeRequest(r, &req); err != nil {
+		t.Fatalf("decodeRequest: %v", err)
+	}
+	if got := req.GetComment().GetBody(); got != "hi" {
+		t.Errorf("comment body = %q, want %q", got, "hi")
+	}
+}
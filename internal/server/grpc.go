@@ -2,32 +2,57 @@ package server
 
 import (
 	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/service"
 
+	myjwt "kratos-realworld/internal/pkg/jwt"
+
 	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	kjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/golang-jwt/jwt/v5"
+	ggrpc "google.golang.org/grpc"
 )
 
+// GRPCServer is a gRPC server that ends the open subscription streams when it stops,
+// GracefulStop 会一直等到所有流结束
+type GRPCServer struct {
+	*grpc.Server
+	closeStreams context.CancelFunc
+}
+
+// Stop ends the streams, then stops the server gracefully.
+func (s *GRPCServer) Stop(ctx context.Context) error {
+	s.closeStreams()
+	return s.Server.Stop(ctx)
+}
+
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, a *conf.Auth, realworld *service.RealWorldService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, a *conf.Auth, realworld *service.RealWorldService, pr *biz.PresenceUsecase, logger log.Logger) *GRPCServer {
+	auth := selector.Server(kjwt.Server(func(token *jwt.Token) (interface{}, error) {
+		return []byte(a.JwtSecret), nil
+	}, kjwt.WithClaims(func() jwt.Claims {
+		// 和 HTTP 一样解析成自定义 claims，service 层才能拿到用户 id
+		return &myjwt.CustomClaims{}
+	}))).Match(func(ctx context.Context, operation string) bool {
+		// 这里返回 true 表示需要 JWT 鉴权
+		// 登录和注册接口跳过鉴权
+		return operation != "/realworld.v1.RealWorld/Registrat" && operation != "/realworld.v1.RealWorld/Login"
+	}).Build()
+	closing, closeStreams := context.WithCancel(context.Background())
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
-			selector.Server(kjwt.Server(func(token *jwt.Token) (interface{}, error) {
-				return []byte(a.JwtSecret), nil
-			})).Match(func(ctx context.Context, operation string) bool {
-				// 这里返回 true 表示需要 JWT 鉴权
-				// 登录和注册接口跳过鉴权
-				return operation != "/realworld.v1.RealWorld/Registrat" && operation != "/realworld.v1.RealWorld/Login"
-			}).Build(),
+			auth,
+			presence(pr),
 		),
+		grpc.StreamInterceptor(streamMiddleware(closing, recovery.Recovery(), auth, presence(pr))),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -40,5 +65,21 @@ func NewGRPCServer(c *conf.Server, a *conf.Auth, realworld *service.RealWorldSer
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterRealWorldServer(srv, realworld)
-	return srv
+	return &GRPCServer{Server: srv, closeStreams: closeStreams}
+}
+
+// streamMiddleware kratos 的 middleware 只作用于 unary 调用，流式调用在建立时跑一遍同样的 middleware，
+// 用鉴权后的 ctx 处理整个流；closing 结束时所有流的 ctx 一起结束
+func streamMiddleware(closing context.Context, m ...middleware.Middleware) ggrpc.StreamServerInterceptor {
+	chain := middleware.Chain(m...)
+	return func(srv interface{}, ss ggrpc.ServerStream, info *ggrpc.StreamServerInfo, handler ggrpc.StreamHandler) error {
+		_, err := chain(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stop := context.AfterFunc(closing, cancel)
+			defer stop()
+			return nil, handler(srv, grpc.NewWrappedStream(ctx, ss))
+		})(ss.Context(), nil)
+		return err
+	}
 }
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/service"
 
@@ -17,27 +18,32 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, a *conf.Auth, realworld *service.RealWorldService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, a *conf.Auth, realworld *service.RealWorldService, pr *biz.PresenceUsecase, logger log.Logger) *http.Server {
+	auth := kjwt.Server(
+		func(token *jwt.Token) (interface{}, error) {
+			return []byte(a.JwtSecret), nil
+		},
+		// ✅ 指定使用你的自定义 claims
+		kjwt.WithClaims(func() jwt.Claims {
+			return &myjwt.CustomClaims{}
+		}),
+	)
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
-			selector.Server(
-				kjwt.Server(
-					func(token *jwt.Token) (interface{}, error) {
-						return []byte(a.JwtSecret), nil
-					},
-					// ✅ 指定使用你的自定义 claims
-					kjwt.WithClaims(func() jwt.Claims {
-						return &myjwt.CustomClaims{}
-					}),
-				),
-			).Match(func(ctx context.Context, operation string) bool {
+			selector.Server(auth).Match(func(ctx context.Context, operation string) bool {
 				// 这里返回 true 表示需要 JWT 鉴权
 				// 登录和注册接口跳过鉴权
 				return operation != "/realworld.v1.RealWorld/Login" && operation != "/realworld.v1.RealWorld/Register"
 			}).Build(),
+			presence(pr),
 		),
 	}
+	// 更新接口需要区分 JSON 中显式的 null 和没传的字段
+	opts = append(opts, http.RequestDecoder(decodeRequest))
+	// 实时推送，和其他接口用同一个 jwt 校验，服务器关闭时断开
+	closing, closeLive := context.WithCancel(context.Background())
+	opts = append(opts, http.Filter(liveFilter("/api/live", realworld.LiveUpdates, auth, closing)))
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
 	}
@@ -48,6 +54,7 @@ func NewHTTPServer(c *conf.Server, a *conf.Auth, realworld *service.RealWorldSer
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.RegisterOnShutdown(closeLive)
 	v1.RegisterRealWorldHTTPServer(srv, realworld)
 	return srv
 }
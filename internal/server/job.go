@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*JobServer)(nil)
+
+// Job is a background task run periodically by the JobServer.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(context.Context) error
+	// Exclusive 为 true 时每个周期只有一个实例执行，其余实例跳过
+	Exclusive bool
+}
+
+// JobServer runs periodic background jobs alongside the HTTP and gRPC servers.
+type JobServer struct {
+	jobs   []Job
+	locker biz.Locker
+	log    *log.Helper
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobServer new a background job server.
+func NewJobServer(locker biz.Locker, suggestion *biz.SuggestionUsecase, schedule *biz.ScheduleUsecase, trending *biz.TrendingUsecase, webhook *biz.WebhookUsecase, outbox *biz.OutboxUsecase, presence *biz.PresenceUsecase, logger log.Logger) *JobServer {
+	return &JobServer{
+		jobs: []Job{
+			{Name: "suggestion", Interval: suggestion.Interval(), Run: suggestion.Refresh, Exclusive: true},
+			// 定时发布自带单篇文章锁，所有实例都可以扫描
+			{Name: "schedule", Interval: schedule.Interval(), Run: schedule.PublishDue},
+			// 落库靠 SPOP 分批取走待写集合，多个实例同时执行也不会重复
+			{Name: "views", Interval: trending.FlushInterval(), Run: trending.FlushViews},
+			// 投递队列领取时带租约，多个实例可以一起投递
+			{Name: "webhooks", Interval: webhook.Interval(), Run: webhook.Deliver},
+			// 中继自己加锁，保证同一时间只有一个实例按顺序发布
+			{Name: "outbox", Interval: outbox.Interval(), Run: outbox.Relay},
+			// 过期的在线用户由脚本原子取走，多个实例一起清理也只推送一次下线
+			{Name: "presence", Interval: presence.Interval(), Run: presence.SweepOffline},
+		},
+		locker: locker,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Start runs every job until Stop is called.
+func (s *JobServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+	s.log.Infof("[Job] server started with %d jobs", len(s.jobs))
+	<-ctx.Done()
+	s.wg.Wait()
+	return nil
+}
+
+// Stop cancels the running jobs and waits for them to return.
+func (s *JobServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	s.log.Info("[Job] server stopped")
+	return nil
+}
+
+func (s *JobServer) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		//启动时先跑一轮，之后按周期执行
+		if err := s.run(ctx, job); err != nil && ctx.Err() == nil {
+			s.log.Errorf("[Job] %s failed: %v", job.Name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *JobServer) run(ctx context.Context, job Job) error {
+	if !job.Exclusive {
+		return job.Run(ctx)
+	}
+	// 锁持有一个周期不主动释放，同一周期内其他实例拿不到锁
+	_, ok, err := s.locker.TryLock(ctx, "job:"+job.Name, job.Interval)
+	if err != nil || !ok {
+		return err
+	}
+	return job.Run(ctx)
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	nethttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// liveOperation 推送连接不经过路由，没有生成的 operation，中间件里用这个名字
+const liveOperation = "/realworld.v1.RealWorld/LiveUpdates"
+
+// liveFilter 把 SSE 长连接直接交给 h，不经过路由：路由上的 http.Timeout 会在一秒后断开连接。
+// 带 Authorization 头的连接先经过和其他接口相同的 auth 中间件，没有时由 h 校验一次性票据。
+// closing 结束时（服务器开始关闭）所有连接一起结束，否则 Shutdown 会一直等这些连接
+func liveFilter(path string, h nethttp.HandlerFunc, auth middleware.Middleware, closing context.Context) http.FilterFunc {
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Method != nethttp.MethodGet || r.URL.Path != path {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			stop := context.AfterFunc(closing, cancel)
+			defer stop()
+			if r.Header.Get("Authorization") == "" {
+				h(w, r.WithContext(ctx))
+				return
+			}
+			ctx = transport.NewServerContext(ctx, &liveTransport{request: r, reply: w.Header()})
+			_, err := auth(func(ctx context.Context, _ interface{}) (interface{}, error) {
+				h(w, r.WithContext(ctx))
+				return nil, nil
+			})(ctx, nil)
+			if err != nil {
+				http.DefaultErrorEncoder(w, r, err)
+			}
+		})
+	}
+}
+
+// liveTransport 让中间件能从推送连接上读到请求头，kratos 的 http.Transport 只能由路由创建
+type liveTransport struct {
+	request *nethttp.Request
+	reply   nethttp.Header
+}
+
+func (t *liveTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *liveTransport) Endpoint() string                { return "" }
+func (t *liveTransport) Operation() string               { return liveOperation }
+func (t *liveTransport) RequestHeader() transport.Header { return headerCarrier(t.request.Header) }
+func (t *liveTransport) ReplyHeader() transport.Header   { return headerCarrier(t.reply) }
+
+type headerCarrier nethttp.Header
+
+func (hc headerCarrier) Get(key string) string { return nethttp.Header(hc).Get(key) }
+
+func (hc headerCarrier) Set(key string, value string) { nethttp.Header(hc).Set(key, value) }
+
+func (hc headerCarrier) Add(key string, value string) { nethttp.Header(hc).Add(key, value) }
+
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range nethttp.Header(hc) {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (hc headerCarrier) Values(key string) []string { return nethttp.Header(hc).Values(key) }
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"context"
+
+	"kratos-realworld/internal/biz"
+	myjwt "kratos-realworld/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	kjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+)
+
+// presence 放在鉴权之后，带登录态的请求刷新用户的在线状态；没有登录态的请求（登录、注册）直接放过
+func presence(pr *biz.PresenceUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if claims, ok := kjwt.FromContext(ctx); ok {
+				if c, ok := claims.(*myjwt.CustomClaims); ok && c.UserID > 0 {
+					pr.Touch(ctx, c.UserID)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func (s *RealWorldService) ListArticles(ctx context.Context, req *pb.ListArticlesRequest) (*pb.MultipleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listArticles, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, total, next, err := s.uc.ListArticles(ctx, userID, &biz.ArticleFilter{
+		Tag:       req.Tag,
+		Author:    req.Author,
+		Favorited: req.Favorited,
+	}, page)
+	if err != nil {
+		return nil, err
+	}
+	return s.multipleArticleReply(ctx, userID, list, total, s.nextCursor(listArticles, next))
+}
+
+func (s *RealWorldService) FeedArticles(ctx context.Context, req *pb.FeedArticlesRequest) (*pb.MultipleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listFeed, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, total, next, err := s.uc.FeedArticles(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return s.multipleArticleReply(ctx, userID, list, total, s.nextCursor(listFeed, next))
+}
+
+func (s *RealWorldService) ListDrafts(ctx context.Context, req *pb.ListDraftsRequest) (*pb.MultipleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listDrafts, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, total, next, err := s.uc.ListDrafts(ctx, userID, page)
+	if err != nil {
+		return nil, err
+	}
+	return s.multipleArticleReply(ctx, userID, list, total, s.nextCursor(listDrafts, next))
+}
+
+func (s *RealWorldService) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	art, err := s.loadArticle(ctx, userID, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	// 只有真正的读取才算一次浏览
+	s.tr.RecordView(ctx, &art.Article, userID, viewerKey(ctx, userID))
+	return s.articleReply(ctx, userID, art, req.Html)
+}
+
+// loadArticle 按 slug 读取当前用户可见的文章；GET 旧 slug 时返回 301
+func (s *RealWorldService) loadArticle(ctx context.Context, userID int64, slug string) (*biz.ArticleView, error) {
+	slug, err := s.canonicalSlug(ctx, userID, slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.uc.GetArticle(ctx, userID, slug)
+}
+
+// reloadArticle 收藏、改状态等写操作之后返回文章的最新内容，不记浏览量
+func (s *RealWorldService) reloadArticle(ctx context.Context, userID int64, slug string) (*pb.SingleArticleReply, error) {
+	art, err := s.loadArticle(ctx, userID, slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.articleReply(ctx, userID, art, false)
+}
+
+// articleReply 组装单篇文章的回复，带上表态、提及，html 为 true 时附带渲染后的正文
+func (s *RealWorldService) articleReply(ctx context.Context, userID int64, art *biz.ArticleView, html bool) (*pb.SingleArticleReply, error) {
+	setETag(ctx, art.Version)
+	reply := singleArticleReply(art)
+	reactions, err := s.rc.Reactions(ctx, userID, biz.ReactionTargetArticle, []int64{art.ID})
+	if err != nil {
+		return nil, err
+	}
+	reply.Article.Reactions = reactionsReply(reactions[art.ID])
+	if reply.Article.Mentions, err = s.articleMentions(ctx, art.ID); err != nil {
+		return nil, err
+	}
+	if html {
+		if reply.Article.BodyHtml, err = s.md.ArticleHTML(ctx, &art.Article); err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) FavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.FavoriteArticle(ctx, userID, req.Slug); err != nil {
+		return nil, err
+	}
+	return s.reloadArticle(ctx, userID, req.Slug)
+}
+
+func (s *RealWorldService) UnFavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.UnfavoriteArticle(ctx, userID, req.Slug); err != nil {
+		return nil, err
+	}
+	return s.reloadArticle(ctx, userID, req.Slug)
+}
+
+func (s *RealWorldService) PublishArticle(ctx context.Context, req *pb.PublishArticleRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.uc.PublishArticle(ctx, userID, req.Slug, req.Unlisted); err != nil {
+		return nil, err
+	}
+	return s.reloadArticle(ctx, userID, req.Slug)
+}
+
+func (s *RealWorldService) ScheduleArticle(ctx context.Context, req *pb.ScheduleArticleRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var at *time.Time
+	if req.ScheduledAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ScheduledAt)
+		if err != nil {
+			return nil, errors.BadRequest("scheduledAt must be RFC 3339", req.ScheduledAt)
+		}
+		at = &t
+	}
+	if _, err := s.sch.ScheduleArticle(ctx, userID, req.Slug, at); err != nil {
+		return nil, err
+	}
+	return s.reloadArticle(ctx, userID, req.Slug)
+}
+
+func (s *RealWorldService) UnpublishArticle(ctx context.Context, req *pb.ArticleStatusRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.uc.UnpublishArticle(ctx, userID, req.Slug); err != nil {
+		return nil, err
+	}
+	return s.reloadArticle(ctx, userID, req.Slug)
+}
+
+func (s *RealWorldService) ArchiveArticle(ctx context.Context, req *pb.ArticleStatusRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.uc.ArchiveArticle(ctx, userID, req.Slug); err != nil {
+		return nil, err
+	}
+	return s.reloadArticle(ctx, userID, req.Slug)
+}
+
+func singleArticleReply(a *biz.ArticleView) *pb.SingleArticleReply {
+	return &pb.SingleArticleReply{
+		Article: &pb.SingleArticleReply_Article{
+			Slug:           a.Slug,
+			Title:          a.Title,
+			Description:    a.Description,
+			Body:           a.Body,
+			TagList:        a.TagList,
+			CreatedAt:      formatTime(a.CreatedAt),
+			UpdatedAt:      formatTime(a.UpdatedAt),
+			Favorited:      a.Favorited,
+			FavoritesCount: int32(a.FavoritesCount),
+			Author: &pb.SingleArticleReply_Article_Author{
+				Username:  a.AuthorName,
+				Bio:       a.AuthorBio,
+				Image:     a.AuthorImage,
+				Following: a.Following,
+			},
+			Status:      a.Status,
+			PublishedAt: formatOptionalTime(a.PublishedAt),
+			ScheduledAt: formatOptionalTime(a.ScheduledAt),
+			Version:     a.Version,
+			WordCount:   int32(a.WordCount),
+			ReadingTime: int32(a.ReadingMinutes),
+			Toc:         tocReply(a.TOC),
+			ViewsCount:  a.ViewsCount,
+		},
+	}
+}
+
+func tocReply(toc biz.Headings) []*pb.SingleArticleReply_Article_Heading {
+	list := make([]*pb.SingleArticleReply_Article_Heading, 0, len(toc))
+	for _, h := range toc {
+		list = append(list, &pb.SingleArticleReply_Article_Heading{Level: int32(h.Level), Text: h.Text, Id: h.ID})
+	}
+	return list
+}
+
+func (s *RealWorldService) multipleArticleReply(ctx context.Context, myid int64, list []*biz.ArticleView, total int64, next string) (*pb.MultipleArticleReply, error) {
+	ids := make([]int64, 0, len(list))
+	for _, a := range list {
+		ids = append(ids, a.ID)
+	}
+	reactions, err := s.rc.Reactions(ctx, myid, biz.ReactionTargetArticle, ids)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleArticleReply{
+		Articles:      make([]*pb.MultipleArticleReply_Article, 0, len(list)),
+		ArticlesCount: int32(total),
+		NextCursor:    next,
+	}
+	for _, a := range list {
+		reply.Articles = append(reply.Articles, &pb.MultipleArticleReply_Article{
+			Slug:           a.Slug,
+			Title:          a.Title,
+			Description:    a.Description,
+			TagList:        a.TagList,
+			CreatedAt:      formatTime(a.CreatedAt),
+			UpdatedAt:      formatTime(a.UpdatedAt),
+			Favorited:      a.Favorited,
+			FavoritesCount: int32(a.FavoritesCount),
+			Author: &pb.MultipleArticleReply_Article_Author{
+				Username:  a.AuthorName,
+				Bio:       a.AuthorBio,
+				Image:     a.AuthorImage,
+				Following: a.Following,
+			},
+			ViewsCount: a.ViewsCount,
+			Reactions:  reactionsReply(reactions[a.ID]),
+		})
+	}
+	return reply, nil
+}
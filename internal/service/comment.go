@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *RealWorldService) AddComments(ctx context.Context, req *pb.AddCommentsRequest) (*pb.SingleCommentReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Comment == nil || req.Comment.Body == "" {
+		return nil, errors.BadRequest("comment body is required", "")
+	}
+	slug, err := s.canonicalSlug(ctx, userID, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.uc.AddComment(ctx, userID, slug, req.Comment.Body, int64(req.Comment.ParentId))
+	if err != nil {
+		return nil, err
+	}
+	return s.singleCommentReply(ctx, userID, slug, c, req.Html)
+}
+
+func (s *RealWorldService) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (*pb.MultipleCommentReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listComments, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := s.canonicalSlug(ctx, userID, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	list, next, err := s.uc.ListComments(ctx, userID, slug, int64(req.ParentId), page)
+	if err != nil {
+		return nil, err
+	}
+	var html map[int64]string
+	if req.Html {
+		if html, err = s.md.CommentsHTML(ctx, list); err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int64, 0, len(list))
+	for _, c := range list {
+		if !c.Deleted() {
+			ids = append(ids, c.ID)
+		}
+	}
+	reactions, err := s.rc.Reactions(ctx, userID, biz.ReactionTargetComment, ids)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := s.uc.Mentions(ctx, biz.MentionSourceComment, ids)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleCommentReply{
+		Slug:       slug,
+		Comments:   make([]*pb.MultipleCommentReply_Comment, 0, len(list)),
+		NextCursor: s.nextCursor(listComments, next),
+	}
+	for _, c := range list {
+		item := &pb.MultipleCommentReply_Comment{
+			Id:         int32(c.ID),
+			CreatedAt:  formatTime(c.CreatedAt),
+			UpdatedAt:  formatTime(c.UpdatedAt),
+			Body:       c.Body,
+			BodyHtml:   html[c.ID],
+			ParentId:   commentParentID(&c.Comment),
+			Depth:      int32(c.Depth),
+			ReplyCount: int32(c.ReplyCount),
+			Deleted:    c.Deleted(),
+			Edited:     c.Edited(),
+		}
+		if c.Deleted() {
+			// 占位只保留楼层结构，不暴露原作者
+			item.Body = deletedCommentBody
+			item.BodyHtml = ""
+		} else {
+			item.Author = &pb.MultipleCommentReply_Comment_Author{
+				Username:  c.AuthorName,
+				Bio:       c.AuthorBio,
+				Image:     c.AuthorImage,
+				Following: c.Following,
+			}
+			item.Reactions = reactionsReply(reactions[c.ID])
+			item.Mentions = mentionsReply(mentions[c.ID])
+		}
+		reply.Comments = append(reply.Comments, item)
+	}
+	return reply, nil
+}
+
+// 已删除但还有回复的评论显示的正文
+const deletedCommentBody = "[deleted]"
+
+func commentParentID(c *biz.Comment) int32 {
+	if c.ParentID == nil {
+		return 0
+	}
+	return int32(*c.ParentID)
+}
+
+func (s *RealWorldService) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.SingleCommentReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Comment == nil || req.Comment.Body == "" {
+		return nil, errors.BadRequest("comment body is required", "")
+	}
+	slug, err := s.canonicalSlug(ctx, userID, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.uc.UpdateComment(ctx, userID, slug, int64(req.Id), req.Comment.Body)
+	if err != nil {
+		return nil, err
+	}
+	return s.singleCommentReply(ctx, userID, slug, c, req.Html)
+}
+
+func (s *RealWorldService) ListCommentEdits(ctx context.Context, req *pb.ListCommentEditsRequest) (*pb.MultipleCommentEditReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := s.canonicalSlug(ctx, userID, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.uc.ListCommentEdits(ctx, userID, slug, int64(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleCommentEditReply{
+		Edits: make([]*pb.MultipleCommentEditReply_Edit, 0, len(list)),
+	}
+	for _, e := range list {
+		reply.Edits = append(reply.Edits, &pb.MultipleCommentEditReply_Edit{
+			Body:       e.Body,
+			ReplacedAt: formatTime(e.CreatedAt),
+		})
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*emptypb.Empty, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteComment(ctx, userID, req.Slug, int64(req.Id)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RealWorldService) singleCommentReply(ctx context.Context, myid int64, slug string, c *biz.CommentView, withHTML bool) (*pb.SingleCommentReply, error) {
+	var html map[int64]string
+	if withHTML {
+		var err error
+		if html, err = s.md.CommentsHTML(ctx, []*biz.CommentView{c}); err != nil {
+			return nil, err
+		}
+	}
+	reactions, err := s.rc.Reactions(ctx, myid, biz.ReactionTargetComment, []int64{c.ID})
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := s.uc.Mentions(ctx, biz.MentionSourceComment, []int64{c.ID})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleCommentReply{
+		Slug: slug,
+		Comment: &pb.SingleCommentReply_Comment{
+			Id:        int32(c.ID),
+			CreatedAt: formatTime(c.CreatedAt),
+			UpdatedAt: formatTime(c.UpdatedAt),
+			Body:      c.Body,
+			BodyHtml:  html[c.ID],
+			Author: &pb.SingleCommentReply_Comment_Author{
+				Username:  c.AuthorName,
+				Bio:       c.AuthorBio,
+				Image:     c.AuthorImage,
+				Following: c.Following,
+			},
+			ParentId:  commentParentID(&c.Comment),
+			Depth:     int32(c.Depth),
+			Edited:    c.Edited(),
+			Reactions: reactionsReply(reactions[c.ID]),
+			Mentions:  mentionsReply(mentions[c.ID]),
+		},
+	}, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// errPreconditionFailed If-Match 与当前版本不一致时返回 412
+var errPreconditionFailed = errors.New(412, v1.ErrorReason_VERSION_CONFLICT.String(), "If-Match does not match the current version")
+
+// setETag 把资源版本写到响应头，HTTP 为 ETag，gRPC 为同名的 header metadata
+func setETag(ctx context.Context, version int64) {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+	}
+}
+
+// expectedVersion 合并 If-Match 头和请求体里的 version，返回期望版本以及它是否来自 If-Match
+func expectedVersion(ctx context.Context, field int64) (int64, bool, error) {
+	var ifMatch string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		ifMatch = strings.TrimSpace(tr.RequestHeader().Get("If-Match"))
+	}
+	if ifMatch == "" {
+		return field, false, nil
+	}
+	if ifMatch == "*" {
+		return biz.AnyVersion, true, nil
+	}
+	// If-Match 只做强比较，弱 ETag 永远不匹配
+	if strings.HasPrefix(ifMatch, "W/") {
+		return 0, true, errPreconditionFailed
+	}
+	tag, err := strconv.Unquote(ifMatch)
+	if err != nil {
+		return 0, true, errors.BadRequest("If-Match must be a single entity tag", ifMatch)
+	}
+	v, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || v <= 0 {
+		// 不是我们签发的 ETag，不可能匹配
+		return 0, true, errPreconditionFailed
+	}
+	if field != 0 && field != v {
+		return 0, true, errors.BadRequest("If-Match and version disagree", "")
+	}
+	return v, true, nil
+}
+
+// versionError 期望版本来自 If-Match 时，版本冲突按 HTTP 语义返回 412 而不是 409
+func versionError(err error, fromHeader bool) error {
+	if fromHeader && errors.Is(err, biz.ErrVersionConflict) {
+		return errPreconditionFailed
+	}
+	return err
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"time"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	kjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 断线后浏览器重连前等待的毫秒数
+const liveRetryMillis = 3000
+
+// LiveUpdates streams notifications, feed updates and, with ?article=slug, new comments on that article as
+// server-sent events. Reconnecting clients send Last-Event-ID to get the events they missed.
+//
+// EventSource 不能设置请求头，用 ?ticket= 带上 CreateLiveTicket 换来的一次性票据，重连前换一张新的；
+// Last-Event-ID 同理可以用 ?lastEventId=
+func (s *RealWorldService) LiveUpdates(w nethttp.ResponseWriter, r *nethttp.Request) {
+	userID, err := s.liveUser(r)
+	if err != nil {
+		http.DefaultErrorEncoder(w, r, err)
+		return
+	}
+	flusher, ok := w.(nethttp.Flusher)
+	if !ok {
+		http.DefaultErrorEncoder(w, r, errors.InternalServer("streaming unsupported", ""))
+		return
+	}
+	ctx := r.Context()
+	slug := r.URL.Query().Get("article")
+	if slug != "" {
+		if slug, err = s.canonicalSlug(ctx, userID, slug); err != nil {
+			http.DefaultErrorEncoder(w, r, err)
+			return
+		}
+	}
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" {
+		lastID = r.URL.Query().Get("lastEventId")
+	}
+	events, err := s.lv.Subscribe(ctx, userID, slug, lastID)
+	if err != nil {
+		http.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	// 关掉 nginx 的响应缓冲，否则事件会攒到缓冲区满才发出
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(nethttp.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", liveRetryMillis)
+	flusher.Flush()
+	// 推送连接不经过中间件，连接期间一直算在线
+	s.pr.Touch(ctx, userID)
+
+	heartbeat := time.NewTicker(s.lv.Heartbeat())
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			s.pr.Touch(ctx, userID)
+		}
+		flusher.Flush()
+	}
+}
+
+// liveUser 返回推送连接的用户：带 Authorization 头的连接已经和其他接口一样由 jwt 中间件校验过，
+// 没有时用掉 ?ticket= 中的票据
+func (s *RealWorldService) liveUser(r *nethttp.Request) (int64, error) {
+	ctx := r.Context()
+	if _, ok := kjwt.FromContext(ctx); ok {
+		return currentUserID(ctx)
+	}
+	ticket := r.URL.Query().Get("ticket")
+	if ticket == "" {
+		return 0, kjwt.ErrMissingJwtToken
+	}
+	return s.lv.RedeemTicket(ctx, ticket)
+}
+
+func (s *RealWorldService) CreateLiveTicket(ctx context.Context, req *emptypb.Empty) (*pb.LiveTicketReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ticket, ttl, err := s.lv.CreateTicket(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LiveTicketReply{Ticket: ticket, ExpiresIn: int32(ttl / time.Second)}, nil
+}
+
+func (s *RealWorldService) Subscribe(req *pb.SubscribeRequest, stream pb.RealWorld_SubscribeServer) error {
+	ctx := stream.Context()
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	slug := req.Article
+	if slug != "" {
+		if slug, err = s.canonicalSlug(ctx, userID, slug); err != nil {
+			return err
+		}
+	}
+	events, err := s.lv.Subscribe(ctx, userID, slug, req.LastEventId)
+	if err != nil {
+		return err
+	}
+	// 中间件只在建立流时记一次活动，订阅期间定时刷新在线状态
+	heartbeat := time.NewTicker(s.lv.Heartbeat())
+	defer heartbeat.Stop()
+	// Send 在客户端的流控窗口满时阻塞，事件在订阅的缓冲里排队
+	for {
+		select {
+		case <-heartbeat.C:
+			s.pr.Touch(ctx, userID)
+		case e, ok := <-events:
+			if !ok {
+				// 服务端关闭或客户端太慢时订阅被结束，客户端带上最后的事件 id 重新订阅不会丢事件
+				return errors.ServiceUnavailable("subscription closed, subscribe again with lastEventId", "")
+			}
+			msg, err := liveEventReply(e)
+			if err != nil {
+				return err
+			}
+			if msg == nil {
+				continue
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// liveEventReply 把事件转成 gRPC 消息，不认识的事件类型返回 nil
+func liveEventReply(e *biz.LiveEvent) (*pb.LiveEvent, error) {
+	reply := &pb.LiveEvent{Id: e.ID}
+	switch e.Type {
+	case biz.LiveComment:
+		var c biz.LiveCommentEvent
+		if err := json.Unmarshal(e.Data, &c); err != nil {
+			return nil, err
+		}
+		reply.Event = &pb.LiveEvent_Comment_{Comment: &pb.LiveEvent_Comment{
+			Id:          int32(c.ID),
+			ArticleSlug: c.ArticleSlug,
+			ParentId:    int32(c.ParentID),
+			Author:      c.Author,
+			Body:        c.Body,
+			CreatedAt:   formatTime(c.CreatedAt),
+		}}
+	case biz.LiveNotification:
+		var n biz.LiveNotificationEvent
+		if err := json.Unmarshal(e.Data, &n); err != nil {
+			return nil, err
+		}
+		reply.Event = &pb.LiveEvent_Notification_{Notification: &pb.LiveEvent_Notification{
+			Id:   int32(n.ID),
+			Kind: n.Kind,
+		}}
+	case biz.LiveFeed:
+		var f biz.LiveFeedEvent
+		if err := json.Unmarshal(e.Data, &f); err != nil {
+			return nil, err
+		}
+		reply.Event = &pb.LiveEvent_Feed_{Feed: &pb.LiveEvent_Feed{
+			Slug:   f.Slug,
+			Title:  f.Title,
+			Author: f.Author,
+		}}
+	case biz.LivePresence:
+		var p biz.LivePresenceEvent
+		if err := json.Unmarshal(e.Data, &p); err != nil {
+			return nil, err
+		}
+		reply.Event = &pb.LiveEvent_Presence_{Presence: &pb.LiveEvent_Presence{
+			Username:   p.UserName,
+			Online:     p.Online,
+			LastSeenAt: formatTime(p.LastSeenAt),
+		}}
+	default:
+		return nil, nil
+	}
+	return reply, nil
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+)
+
+func mentionsReply(list []*biz.Mention) []*pb.Mention {
+	res := make([]*pb.Mention, 0, len(list))
+	for _, m := range list {
+		res = append(res, &pb.Mention{Username: m.UserName, Start: int32(m.Start), End: int32(m.End)})
+	}
+	return res
+}
+
+// articleMentions 文章正文中的提及
+func (s *RealWorldService) articleMentions(ctx context.Context, id int64) ([]*pb.Mention, error) {
+	m, err := s.uc.Mentions(ctx, biz.MentionSourceArticle, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+	return mentionsReply(m[id]), nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *RealWorldService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.MultipleNotificationReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listNotifications, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, next, err := s.nt.ListNotifications(ctx, userID, req.Unread, page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleNotificationReply{
+		Notifications: make([]*pb.MultipleNotificationReply_Notification, 0, len(list)),
+		NextCursor:    s.nextCursor(listNotifications, next),
+	}
+	for _, n := range list {
+		actors := make([]*pb.MultipleNotificationReply_Notification_Actor, 0, len(n.Actors))
+		for _, a := range n.Actors {
+			actors = append(actors, &pb.MultipleNotificationReply_Notification_Actor{
+				Username: a.UserName,
+				Image:    a.Image,
+			})
+		}
+		reply.Notifications = append(reply.Notifications, &pb.MultipleNotificationReply_Notification{
+			Id:           int32(n.ID),
+			Kind:         n.Kind,
+			Actors:       actors,
+			ActorsCount:  int32(n.ActorsCount),
+			ArticleSlug:  n.ArticleSlug,
+			ArticleTitle: n.ArticleTitle,
+			Message:      n.Summary(),
+			Read:         n.Read(),
+			CreatedAt:    formatTime(n.CreatedAt),
+			UpdatedAt:    formatTime(n.UpdatedAt),
+		})
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) UnreadNotificationCount(ctx context.Context, req *emptypb.Empty) (*pb.UnreadCountReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.nt.UnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnreadCountReply{Count: int32(n)}, nil
+}
+
+func (s *RealWorldService) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*emptypb.Empty, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.nt.MarkRead(ctx, userID, int64(req.Id)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RealWorldService) MarkAllNotificationsRead(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.nt.MarkAllRead(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
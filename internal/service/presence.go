@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+)
+
+func (s *RealWorldService) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.MultiplePresenceReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.pr.Presences(ctx, userID, req.Usernames)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultiplePresenceReply{
+		Presences: make([]*pb.MultiplePresenceReply_Presence, 0, len(list)),
+	}
+	for _, p := range list {
+		reply.Presences = append(reply.Presences, &pb.MultiplePresenceReply_Presence{
+			Username:   p.UserName,
+			Online:     p.Online,
+			LastSeenAt: formatOptionalTime(p.LastSeenAt),
+		})
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) UpdatePresenceSettings(ctx context.Context, req *pb.UpdatePresenceSettingsRequest) (*pb.PresenceSettingsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pr.SetHidden(ctx, userID, req.Hidden); err != nil {
+		return nil, err
+	}
+	return &pb.PresenceSettingsReply{Hidden: req.Hidden}, nil
+}
+
+// profileReply 个人资料连同 myid 能看到的在线状态
+func (s *RealWorldService) profileReply(ctx context.Context, myid int64, user *biz.RealWorld, following bool) *pb.ProfileReply {
+	p := s.pr.Presence(ctx, myid, user)
+	return &pb.ProfileReply{
+		Profile: &pb.ProfileReply_Profile{
+			Username:   user.UserName,
+			Bio:        user.Bio,
+			Image:      user.Image,
+			Following:  following,
+			Online:     p.Online,
+			LastSeenAt: formatOptionalTime(p.LastSeenAt),
+		},
+	}
+}
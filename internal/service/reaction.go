@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+)
+
+func (s *RealWorldService) AddArticleReaction(ctx context.Context, req *pb.ArticleReactionRequest) (*pb.ReactionsReply, error) {
+	return s.react(ctx, req.Slug, 0, req.Reaction, true)
+}
+
+func (s *RealWorldService) RemoveArticleReaction(ctx context.Context, req *pb.ArticleReactionRequest) (*pb.ReactionsReply, error) {
+	return s.react(ctx, req.Slug, 0, req.Reaction, false)
+}
+
+func (s *RealWorldService) AddCommentReaction(ctx context.Context, req *pb.CommentReactionRequest) (*pb.ReactionsReply, error) {
+	return s.react(ctx, req.Slug, int64(req.Id), req.Reaction, true)
+}
+
+func (s *RealWorldService) RemoveCommentReaction(ctx context.Context, req *pb.CommentReactionRequest) (*pb.ReactionsReply, error) {
+	return s.react(ctx, req.Slug, int64(req.Id), req.Reaction, false)
+}
+
+func (s *RealWorldService) react(ctx context.Context, slug string, commentID int64, reaction string, add bool) (*pb.ReactionsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slug, err = s.canonicalSlug(ctx, userID, slug)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.rc.React(ctx, userID, slug, commentID, reaction, add)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReactionsReply{Reactions: reactionsReply(list)}, nil
+}
+
+func reactionsReply(list []*biz.ReactionCount) []*pb.Reaction {
+	out := make([]*pb.Reaction, 0, len(list))
+	for _, r := range list {
+		out = append(out, &pb.Reaction{Reaction: r.Reaction, Count: int32(r.Count), Reacted: r.Reacted})
+	}
+	return out
+}
@@ -2,9 +2,9 @@ package service
 
 import (
 	"context"
-	"fmt"
 	pb "kratos-realworld/api/realworld/v1"
 	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/pkg/cursor"
 	"kratos-realworld/internal/pkg/jwt"
 	"strings"
 	"time"
@@ -13,19 +13,47 @@ import (
 
 	//"golang.org/x/crypto/bcrypt"
 	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type RealWorldService struct {
 	uc  *biz.RealWorldUsecase
+	su  *biz.SuggestionUsecase
+	sc  *biz.SearchUsecase
+	sch *biz.ScheduleUsecase
+	rv  *biz.RevisionUsecase
+	md  *biz.MarkdownUsecase
+	tr  *biz.TrendingUsecase
+	rel *biz.RelatedUsecase
+	rc  *biz.ReactionUsecase
+	nt  *biz.NotificationUsecase
+	lv  *biz.LiveUsecase
+	pr  *biz.PresenceUsecase
+	wh  *biz.WebhookUsecase
 	jwt *jwt.JWTService
+	cur *cursor.Codec
 	pb.UnimplementedRealWorldServer
 }
 
-func NewRealWorldService(uc *biz.RealWorldUsecase, jwt *jwt.JWTService) *RealWorldService {
+func NewRealWorldService(uc *biz.RealWorldUsecase, su *biz.SuggestionUsecase, sc *biz.SearchUsecase, sch *biz.ScheduleUsecase, rv *biz.RevisionUsecase, md *biz.MarkdownUsecase, tr *biz.TrendingUsecase, rel *biz.RelatedUsecase, rc *biz.ReactionUsecase, nt *biz.NotificationUsecase, lv *biz.LiveUsecase, pr *biz.PresenceUsecase, wh *biz.WebhookUsecase, jwt *jwt.JWTService, cur *cursor.Codec) *RealWorldService {
 	return &RealWorldService{
 		uc:  uc,
+		su:  su,
+		sc:  sc,
+		sch: sch,
+		rv:  rv,
+		md:  md,
+		tr:  tr,
+		rel: rel,
+		rc:  rc,
+		nt:  nt,
+		lv:  lv,
+		pr:  pr,
+		wh:  wh,
 		jwt: jwt,
+		cur: cur,
 	}
 }
 
@@ -46,6 +74,8 @@ func (s *RealWorldService) Login(ctx context.Context, req *pb.AuthRequest) (*pb.
 	if err != nil {
 		return nil, err
 	}
+	// 登录接口不经过鉴权中间件，在这里记一次活动
+	s.pr.Touch(ctx, user.ID)
 	return &pb.UserReply{
 		User: &pb.UserReply_User{
 			Email: user.Email,
@@ -104,13 +134,16 @@ func (s *RealWorldService) GetCurrentUser(ctx context.Context, req *emptypb.Empt
 		if err != nil {
 			return nil, err
 		}
+		setETag(ctx, user.Version)
 		return &pb.UserReply{
 			User: &pb.UserReply_User{
-				Email:    user.Email,
-				Token:    newtoken,
-				Username: user.UserName,
-				Bio:      user.Bio,
-				Image:    user.Image,
+				Email:        user.Email,
+				Token:        newtoken,
+				Username:     user.UserName,
+				Bio:          user.Bio,
+				Image:        user.Image,
+				Version:      user.Version,
+				HidePresence: user.HidePresence,
 			},
 		}, nil
 	}
@@ -131,30 +164,38 @@ func (s *RealWorldService) UpdateUser(ctx context.Context, req *pb.UpdateUserReq
 	if userID <= 0 || email == "" {
 		return nil, errors.BadRequest("jwt no valied data", "")
 	}
+	if req.User == nil {
+		return nil, errors.BadRequest("user is required", "")
+	}
+	fields, err := updateFields(req.UpdateMask, req.User)
+	if err != nil {
+		return nil, err
+	}
 	user := &biz.RealWorld{
 		ID:    userID,
 		Email: email,
 	}
-	if req.User.Username != "" {
-		user.UserName = req.User.Username
-	}
-	if req.User.Bio != "" {
-		user.Bio = req.User.Bio
-	}
-	if req.User.Image != "" {
-		user.Image = req.User.Image
+	user.UserName = req.User.Username
+	user.Bio = req.User.Bio
+	user.Image = req.User.Image
+	version, fromHeader, err := expectedVersion(ctx, req.User.Version)
+	if err != nil {
+		return nil, err
 	}
+	user.Version = version
 	//对数据进行判定是否存在
 
 	//调用uc层的更新方法
-	user, err := s.uc.UpdateUser(ctx, user)
+	user, err = s.uc.UpdateUser(ctx, user, fields)
 	if err != nil {
-		return nil, err
+		return nil, versionError(err, fromHeader)
 	}
 
+	setETag(ctx, user.Version)
 	return &pb.UserReply{
 		User: &pb.UserReply_User{
 			Username: user.UserName,
+			Version:  user.Version,
 		},
 	}, nil
 }
@@ -174,15 +215,7 @@ func (s *RealWorldService) GetProfile(ctx context.Context, req *pb.GetProfileReq
 	if user, follow, err := s.uc.GetProfileByUserName(ctx, userID, req.Username); err != nil {
 		return nil, err
 	} else {
-
-		return &pb.ProfileReply{
-			Profile: &pb.ProfileReply_Profile{
-				Username:  user.UserName,
-				Bio:       user.Bio,
-				Image:     user.Image,
-				Following: *follow, //是否关注
-			},
-		}, nil
+		return s.profileReply(ctx, userID, user, *follow), nil
 	}
 
 }
@@ -203,14 +236,9 @@ func (s *RealWorldService) FollowUser(ctx context.Context, req *pb.FollowUserReq
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ProfileReply{
-		Profile: &pb.ProfileReply_Profile{
-			Username:  user.UserName,
-			Bio:       user.Bio,
-			Image:     user.Image,
-			Following: true, //是否关注
-		},
-	}, nil
+	//已关注的人不应再出现在推荐列表中
+	s.su.Forget(ctx, userID, user.ID)
+	return s.profileReply(ctx, userID, user, true), nil
 }
 func (s *RealWorldService) UnFollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb.ProfileReply, error) {
 	//先鉴权拿请求方的id和email信息
@@ -229,23 +257,34 @@ func (s *RealWorldService) UnFollowUser(ctx context.Context, req *pb.FollowUserR
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ProfileReply{
-		Profile: &pb.ProfileReply_Profile{
-			Username:  user.UserName,
-			Bio:       user.Bio,
-			Image:     user.Image,
-			Following: false, //是否关注
-		},
-	}, nil
-}
-func (s *RealWorldService) ListArticles(ctx context.Context, req *pb.ListArticlesRequest) (*pb.MultipleArticleReply, error) {
-	return &pb.MultipleArticleReply{}, nil
+	return s.profileReply(ctx, userID, user, false), nil
 }
-func (s *RealWorldService) FeedArticles(ctx context.Context, req *pb.FeedArticlesRequest) (*pb.MultipleArticleReply, error) {
-	return &pb.MultipleArticleReply{}, nil
-}
-func (s *RealWorldService) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*pb.SingleArticleReply, error) {
-	return &pb.SingleArticleReply{}, nil
+func (s *RealWorldService) ListFollowers(ctx context.Context, req *pb.ListFollowersRequest) (*pb.MultipleProfileReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listFollowers, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, next, err := s.uc.ListFollowers(ctx, userID, req.Username, page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleProfileReply{
+		Profiles:   make([]*pb.MultipleProfileReply_Profile, 0, len(list)),
+		NextCursor: s.nextCursor(listFollowers, next),
+	}
+	for _, u := range list {
+		reply.Profiles = append(reply.Profiles, &pb.MultipleProfileReply_Profile{
+			Username:  u.UserName,
+			Bio:       u.Bio,
+			Image:     u.Image,
+			Following: u.Following,
+		})
+	}
+	return reply, nil
 }
 func (s *RealWorldService) CreateArticle(ctx context.Context, req *pb.CreateArticleRequest) (*pb.SingleArticleReply, error) {
 	//从ctx中获取当前用户的id
@@ -270,12 +309,16 @@ func (s *RealWorldService) CreateArticle(ctx context.Context, req *pb.CreateArti
 		Title:       req.Article.Title,
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
-		Slug:        GenerateSlug(req.Article.Title),
+		Status:      req.Article.Status,
 	}, &req.Article.TagList)
 
 	if err != nil {
 		return nil, err
 	} else {
+		mentions, err := s.articleMentions(ctx, art.ID)
+		if err != nil {
+			return nil, err
+		}
 		return &pb.SingleArticleReply{
 			Article: &pb.SingleArticleReply_Article{
 				Slug:        art.Slug,
@@ -288,6 +331,13 @@ func (s *RealWorldService) CreateArticle(ctx context.Context, req *pb.CreateArti
 				Favorited:      false,
 				FavoritesCount: 0,
 				//	Author: userID,
+				Status:      art.Status,
+				PublishedAt: formatOptionalTime(art.PublishedAt),
+				Version:     art.Version,
+				WordCount:   int32(art.WordCount),
+				ReadingTime: int32(art.ReadingMinutes),
+				Toc:         tocReply(art.TOC),
+				Mentions:    mentions,
 			},
 		}, nil
 	}
@@ -306,15 +356,32 @@ func (s *RealWorldService) UpdateArticle(ctx context.Context, req *pb.UpdateArti
 		return nil, errors.BadRequest("jwt no valied data", "")
 	}
 
+	if req.Article == nil {
+		return nil, errors.BadRequest("article is required", "")
+	}
+	fields, err := updateFields(req.UpdateMask, req.Article)
+	if err != nil {
+		return nil, err
+	}
+	version, fromHeader, err := expectedVersion(ctx, req.Article.Version)
+	if err != nil {
+		return nil, err
+	}
   	art ,err := s.uc.UpdateArticle(ctx,&biz.Article{
 		AuthorID:    userID,
 		Title:       req.Article.Title,
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
 		Slug:        req.Slug,
-	})
-	if err != nil{
-		return nil,err
+		Version:     version,
+	}, fields)
+	if err != nil {
+		return nil, versionError(err, fromHeader)
+	}
+	setETag(ctx, art.Version)
+	mentions, err := s.articleMentions(ctx, art.ID)
+	if err != nil {
+		return nil, err
 	}
 	return &pb.SingleArticleReply{
 		Article: &pb.SingleArticleReply_Article{
@@ -328,33 +395,100 @@ func (s *RealWorldService) UpdateArticle(ctx context.Context, req *pb.UpdateArti
 			Favorited:      false,
 			FavoritesCount: 0,
 			//	Author: userID,
+			Status:      art.Status,
+			PublishedAt: formatOptionalTime(art.PublishedAt),
+			Version:     art.Version,
+			WordCount:   int32(art.WordCount),
+			ReadingTime: int32(art.ReadingMinutes),
+			Toc:         tocReply(art.TOC),
+			Mentions:    mentions,
 		},
 	}, nil
 }
 func (s *RealWorldService) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*emptypb.Empty, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteArticle(ctx, userID, req.Slug); err != nil {
+		return nil, err
+	}
 	return &emptypb.Empty{}, nil
 }
-func (s *RealWorldService) AddComments(ctx context.Context, req *pb.AddCommentsRequest) (*pb.SingleCommentReply, error) {
-	return &pb.SingleCommentReply{}, nil
+func (s *RealWorldService) GetTags(ctx context.Context, req *emptypb.Empty) (*pb.ListTagsReply, error) {
+	return &pb.ListTagsReply{}, nil
 }
-func (s *RealWorldService) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (*pb.MultipleCommentReply, error) {
-	return &pb.MultipleCommentReply{}, nil
+
+// currentUserID 从 ctx 中拿到 jwt 中间件解析出的当前用户 id
+func currentUserID(ctx context.Context) (int64, error) {
+	claims, ok := kjwt.FromContext(ctx)
+	if !ok {
+		return 0, errors.Unauthorized("UNAUTHORIZED", "no jwt claims in context")
+	}
+	mapClaims, ok := claims.(*jwt.CustomClaims)
+	if !ok || mapClaims.UserID <= 0 || mapClaims.Email == "" {
+		return 0, errors.BadRequest("jwt no valied data", "")
+	}
+	return mapClaims.UserID, nil
 }
-func (s *RealWorldService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, nil
+
+// updateFields 把 update_mask 转成要更新的字段名；没有 mask 时返回 nil
+func updateFields(mask *fieldmaskpb.FieldMask, m proto.Message) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	mask.Normalize()
+	if !mask.IsValid(m) {
+		return nil, errors.BadRequest("invalid update_mask", strings.Join(mask.GetPaths(), ","))
+	}
+	return mask.GetPaths(), nil
 }
-func (s *RealWorldService) FavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRequest) (*pb.SingleArticleReply, error) {
-	return &pb.SingleArticleReply{}, nil
+
+// cursor 绑定的列表名，各列表的排序列和筛选条件不同，一个列表的 cursor 不能拿到另一个列表上用
+const (
+	listArticles      = "articles"
+	listFeed          = "feed"
+	listDrafts        = "drafts"
+	listComments      = "comments"
+	listFollowers     = "followers"
+	listNotifications = "notifications"
+	listRevisions     = "revisions"
+	listDeliveries    = "deliveries"
+)
+
+// pageFrom 把请求里的分页参数转换成 biz.Page，cursor 需要先验签
+func (s *RealWorldService) pageFrom(list string, limit, offset int32, cur string) (*biz.Page, error) {
+	p := &biz.Page{Limit: int(limit), Offset: int(offset)}
+	if cur != "" {
+		t, id, err := s.cur.Decode(list, cur)
+		if err != nil {
+			return nil, errors.BadRequest("invalid cursor", "")
+		}
+		p.After = &biz.PageCursor{CreatedAt: t, ID: id}
+	}
+	return p, nil
 }
-func (s *RealWorldService) UnFavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRequest) (*pb.SingleArticleReply, error) {
-	return &pb.SingleArticleReply{}, nil
+
+// nextCursor 生成下一页的 cursor，没有下一页时返回空串
+func (s *RealWorldService) nextCursor(list string, next *biz.PageCursor) string {
+	if next == nil {
+		return ""
+	}
+	return s.cur.Encode(list, next.CreatedAt, next.ID)
 }
-func (s *RealWorldService) GetTags(ctx context.Context, req *emptypb.Empty) (*pb.ListTagsReply, error) {
-	return &pb.ListTagsReply{}, nil
+
+// formatTime 按 RealWorld 规范输出 ISO 8601 时间
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02T15:04:05.000Z07:00")
 }
 
-func GenerateSlug(title string) string {
-	base := strings.ToLower(strings.ReplaceAll(title, " ", "-"))
-	unique := fmt.Sprintf("%s-%d", base, time.Now().UnixNano())
-	return unique
+// formatOptionalTime 可空时间为空时输出空串
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatTime(*t)
 }
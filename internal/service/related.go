@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+)
+
+func (s *RealWorldService) RelatedArticles(ctx context.Context, req *pb.RelatedArticlesRequest) (*pb.MultipleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.rel.RelatedArticles(ctx, userID, req.Slug, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return s.multipleArticleReply(ctx, userID, list, int64(len(list)), "")
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+)
+
+func (s *RealWorldService) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (*pb.MultipleRevisionReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listRevisions, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, next, err := s.rv.ListRevisions(ctx, userID, req.Slug, page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleRevisionReply{
+		Revisions:  make([]*pb.MultipleRevisionReply_Revision, 0, len(list)),
+		NextCursor: s.nextCursor(listRevisions, next),
+	}
+	for _, r := range list {
+		reply.Revisions = append(reply.Revisions, &pb.MultipleRevisionReply_Revision{
+			Revision:     r.Revision,
+			Title:        r.Title,
+			Description:  r.Description,
+			CreatedAt:    formatTime(r.CreatedAt),
+			RestoredFrom: restoredFrom(r.RestoredFrom),
+			Editor: &pb.MultipleRevisionReply_Revision_Editor{
+				Username: r.EditorName,
+				Bio:      r.EditorBio,
+				Image:    r.EditorImage,
+			},
+		})
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) GetRevision(ctx context.Context, req *pb.GetRevisionRequest) (*pb.SingleRevisionReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.rv.GetRevision(ctx, userID, req.Slug, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleRevisionReply{
+		Revision: &pb.SingleRevisionReply_Revision{
+			Revision:     r.Revision,
+			Title:        r.Title,
+			Description:  r.Description,
+			Body:         r.Body,
+			CreatedAt:    formatTime(r.CreatedAt),
+			RestoredFrom: restoredFrom(r.RestoredFrom),
+			Editor: &pb.SingleRevisionReply_Revision_Editor{
+				Username: r.EditorName,
+				Bio:      r.EditorBio,
+				Image:    r.EditorImage,
+			},
+		},
+	}, nil
+}
+
+func (s *RealWorldService) DiffRevisions(ctx context.Context, req *pb.DiffRevisionsRequest) (*pb.RevisionDiffReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := s.rv.DiffRevisions(ctx, userID, req.Slug, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevisionDiffReply{From: req.From, To: req.To, Diff: diff}, nil
+}
+
+func (s *RealWorldService) RestoreRevision(ctx context.Context, req *pb.RestoreRevisionRequest) (*pb.SingleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, fromHeader, err := expectedVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	art, err := s.rv.RestoreRevision(ctx, userID, req.Slug, req.Revision, version)
+	if err != nil {
+		return nil, versionError(err, fromHeader)
+	}
+	// 恢复的标题不同时 slug 会变，按新的 slug 返回
+	return s.reloadArticle(ctx, userID, art.Slug)
+}
+
+func restoredFrom(rev *int32) int32 {
+	if rev == nil {
+		return 0
+	}
+	return *rev
+}
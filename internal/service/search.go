@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+)
+
+func (s *RealWorldService) SearchProfiles(ctx context.Context, req *pb.SearchProfilesRequest) (*pb.MultipleProfileReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := s.sc.SearchUsers(ctx, userID, req.Q, req.Autocomplete, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleProfileReply{
+		Profiles: make([]*pb.MultipleProfileReply_Profile, 0, len(hits)),
+	}
+	for _, u := range hits {
+		reply.Profiles = append(reply.Profiles, &pb.MultipleProfileReply_Profile{
+			Username:  u.UserName,
+			Bio:       u.Bio,
+			Image:     u.Image,
+			Following: u.Following,
+		})
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (*pb.SearchArticlesReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hits, total, err := s.sc.SearchArticles(ctx, userID, &biz.ArticleSearch{
+		Query:  req.Q,
+		Tag:    req.Tag,
+		Author: req.Author,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.SearchArticlesReply{
+		Articles:      make([]*pb.SearchArticlesReply_Article, 0, len(hits)),
+		ArticlesCount: int32(total),
+	}
+	for _, a := range hits {
+		reply.Articles = append(reply.Articles, &pb.SearchArticlesReply_Article{
+			Slug:           a.Slug,
+			Title:          a.Title,
+			Description:    a.Description,
+			TagList:        a.TagList,
+			CreatedAt:      formatTime(a.CreatedAt),
+			UpdatedAt:      formatTime(a.UpdatedAt),
+			Favorited:      a.Favorited,
+			FavoritesCount: int32(a.FavoritesCount),
+			Author: &pb.SearchArticlesReply_Article_Author{
+				Username:  a.AuthorName,
+				Bio:       a.AuthorBio,
+				Image:     a.AuthorImage,
+				Following: a.Following,
+			},
+			TitleHighlight: a.TitleHighlight,
+			Snippet:        a.Snippet,
+			Rank:           float32(a.Rank),
+		})
+	}
+	return reply, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+
+	v1 "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// canonicalSlug 把旧 slug 解析成文章当前的 slug。
+// HTTP GET 请求用旧 slug 访问时返回 301 跳转到新地址；gRPC 和写请求照常处理，由回复带上当前 slug
+func (s *RealWorldService) canonicalSlug(ctx context.Context, myid int64, slug string) (string, error) {
+	canonical, err := s.uc.CanonicalSlug(ctx, myid, slug)
+	if err != nil {
+		return "", err
+	}
+	if canonical == slug {
+		return slug, nil
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return canonical, nil
+	}
+	ht, ok := tr.(*http.Transport)
+	if !ok || (ht.Request().Method != nethttp.MethodGet && ht.Request().Method != nethttp.MethodHead) {
+		return canonical, nil
+	}
+	ht.ReplyHeader().Set("Location", movedLocation(ht.Request().URL, slug, canonical))
+	return "", errors.New(nethttp.StatusMovedPermanently, v1.ErrorReason_ARTICLE_MOVED.String(), "article moved").
+		WithMetadata(map[string]string{"slug": canonical})
+}
+
+// movedLocation 把请求路径中的旧 slug 段换成新 slug，保留查询参数
+func movedLocation(u *url.URL, from, to string) string {
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, seg := range segments {
+		if unescaped, err := url.PathUnescape(seg); err == nil && unescaped == from {
+			segments[i] = url.PathEscape(to)
+			break
+		}
+	}
+	loc := strings.Join(segments, "/")
+	if u.RawQuery != "" {
+		loc += "?" + u.RawQuery
+	}
+	return loc
+}
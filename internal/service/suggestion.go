@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+)
+
+func (s *RealWorldService) ListSuggestions(ctx context.Context, req *pb.ListSuggestionsRequest) (*pb.MultipleProfileReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.su.ListSuggestions(ctx, userID, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleProfileReply{
+		Profiles: make([]*pb.MultipleProfileReply_Profile, 0, len(list)),
+	}
+	for _, u := range list {
+		reply.Profiles = append(reply.Profiles, &pb.MultipleProfileReply_Profile{
+			Username:  u.UserName,
+			Bio:       u.Bio,
+			Image:     u.Image,
+			Following: false, //推荐列表里都是尚未关注的人
+		})
+	}
+	return reply, nil
+}
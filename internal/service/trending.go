@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+
+	pb "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+func (s *RealWorldService) TrendingArticles(ctx context.Context, req *pb.TrendingArticlesRequest) (*pb.MultipleArticleReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, total, err := s.tr.TrendingArticles(ctx, userID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return s.multipleArticleReply(ctx, userID, list, total, "")
+}
+
+// viewerKey 标识一个访客：登录用户用 id，匿名访问用客户端 IP 的哈希，避免在 Redis 里存原始 IP
+func viewerKey(ctx context.Context, userID int64) string {
+	if userID > 0 {
+		return "u:" + strconv.FormatInt(userID, 10)
+	}
+	req, ok := http.RequestFromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	ip := strings.TrimSpace(strings.Split(req.Header.Get("X-Forwarded-For"), ",")[0])
+	if ip == "" {
+		if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+			ip = host
+		} else {
+			ip = req.RemoteAddr
+		}
+	}
+	sum := sha256.Sum256([]byte(ip))
+	return "ip:" + hex.EncodeToString(sum[:16])
+}
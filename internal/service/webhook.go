@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+
+	pb "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *RealWorldService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.WebhookReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Webhook == nil || req.Webhook.Url == "" {
+		return nil, errors.BadRequest("url is required", "")
+	}
+	w, err := s.wh.CreateWebhook(ctx, userID, &biz.Webhook{
+		URL:    req.Webhook.Url,
+		Secret: req.Webhook.Secret,
+		Global: req.Webhook.All,
+	}, req.Webhook.Events)
+	if err != nil {
+		return nil, err
+	}
+	reply := webhookReply(w)
+	// 密钥只在创建时返回一次
+	reply.Secret = w.Secret
+	return &pb.WebhookReply{Webhook: reply}, nil
+}
+
+func (s *RealWorldService) ListWebhooks(ctx context.Context, req *emptypb.Empty) (*pb.MultipleWebhookReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.wh.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleWebhookReply{
+		Webhooks: make([]*pb.WebhookReply_Webhook, 0, len(list)),
+	}
+	for _, w := range list {
+		reply.Webhooks = append(reply.Webhooks, webhookReply(w))
+	}
+	return reply, nil
+}
+
+func (s *RealWorldService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.wh.DeleteWebhook(ctx, userID, int64(req.Id)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RealWorldService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.MultipleWebhookDeliveryReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.pageFrom(listDeliveries, req.Limit, req.Offset, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	list, next, err := s.wh.ListDeliveries(ctx, userID, int64(req.Id), req.Status, page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MultipleWebhookDeliveryReply{
+		Deliveries: make([]*pb.MultipleWebhookDeliveryReply_Delivery, 0, len(list)),
+		NextCursor: s.nextCursor(listDeliveries, next),
+	}
+	for _, d := range list {
+		reply.Deliveries = append(reply.Deliveries, &pb.MultipleWebhookDeliveryReply_Delivery{
+			Id:             int32(d.ID),
+			Event:          d.Event,
+			Status:         d.Status,
+			Attempts:       int32(d.Attempts),
+			ResponseStatus: int32(d.ResponseStatus),
+			Error:          d.LastError,
+			CreatedAt:      formatTime(d.CreatedAt),
+			NextAttemptAt:  formatOptionalTime(d.NextAttemptAt),
+			DeliveredAt:    formatOptionalTime(d.DeliveredAt),
+		})
+	}
+	return reply, nil
+}
+
+func webhookReply(w *biz.Webhook) *pb.WebhookReply_Webhook {
+	return &pb.WebhookReply_Webhook{
+		Id:        int32(w.ID),
+		Url:       w.URL,
+		Events:    w.EventList(),
+		All:       w.Global,
+		CreatedAt: formatTime(w.CreatedAt),
+	}
+}